@@ -0,0 +1,9 @@
+// Package assets holds the images, sounds, fonts and maps of the game.
+// They are embedded into the binary so the game can be started from
+// every directory. manifest.json lists every asset the game loads.
+package assets
+
+import "embed"
+
+//go:embed manifest.json audio data fonts images maps
+var Files embed.FS
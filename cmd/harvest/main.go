@@ -1,18 +1,32 @@
 package main
 
 import (
+	"fmt"
 	"log"
 
-	"github.com/N3moAhead/harvest/internal/config"
+	"github.com/N3moAhead/harvest/internal/assets"
+	"github.com/N3moAhead/harvest/internal/display"
 	"github.com/N3moAhead/harvest/internal/scene"
+	"github.com/N3moAhead/harvest/internal/settings"
 	"github.com/hajimehoshi/ebiten/v2"
 )
 
 func init() {
-	ebiten.SetWindowSize(config.SCREEN_WIDTH, config.SCREEN_HEIGHT)
 	ebiten.SetWindowTitle("Harvest by Wurzelwerk")
 	ebiten.SetTPS(60)
-	ebiten.SetFullscreen(true)
+	// The game is scaled to any window size and letterboxed
+	ebiten.SetWindowResizingMode(ebiten.WindowResizingModeEnabled)
+
+	// The settings decide about fullscreen, vsync and the keybinds
+	s, err := settings.Load(settings.Path())
+	if err != nil {
+		fmt.Println("Warning:", err)
+	}
+	settings.SetCurrent(s)
+	s.Apply()
+	assets.ApplyVolumes()
+	// The window starts in the size of the chosen resolution
+	ebiten.SetWindowSize(display.Size())
 }
 
 func main() {
@@ -4,6 +4,8 @@ import (
 	"errors"
 	"fmt"
 	"image"
+	"maps"
+	"slices"
 
 	"github.com/hajimehoshi/ebiten/v2"
 )
@@ -50,8 +52,16 @@ type Animation struct {
 	SourceImage *ebiten.Image
 	// Loops determines if the animation should restart from the beginning after reaching the end.
 	Loops bool
+	// Events maps the name of an event to the frame it happens on.
+	// An event fires every time its frame gets shown, see FiredEvents.
+	Events map[string]int
 	// hasFinished is an internal flag primarily for non-looping animations.
 	hasFinished bool
+	// frameEntered is false until the events of the current frame were fired.
+	// It is false after a reset so the events of the first frame fire as well.
+	frameEntered bool
+	// firedEvents holds the events of the frames shown during the last Update.
+	firedEvents []string
 }
 
 // NewAnimation creates and validates a new Animation instance.
@@ -102,11 +112,18 @@ func NewAnimation(
 // and continue returning true on subsequent calls without updating the frame further.
 // For looping animations, it returns true only on the tick when it loops back to the first frame.
 func (a *Animation) Update() bool {
+	a.firedEvents = a.firedEvents[:0]
+
 	// If the animation doesn't loop and has already finished, do nothing.
 	if !a.Loops && a.hasFinished {
 		return true // Indicate it's (still) finished.
 	}
 
+	// The first frame was shown before the first update
+	if !a.frameEntered {
+		a.enterFrame()
+	}
+
 	animationFinishedThisTick := false
 	a.FrameTimer++
 
@@ -121,6 +138,7 @@ func (a *Animation) Update() bool {
 			if a.Loops {
 				// Loop back to the first frame
 				a.CurrentFrame = 0
+				a.enterFrame()
 			} else {
 				// Stay on the last frame and mark as finished
 				a.hasFinished = true
@@ -129,22 +147,58 @@ func (a *Animation) Update() bool {
 		} else {
 			// Advance to the next frame
 			a.CurrentFrame++
+			a.enterFrame()
 		}
 	}
 
 	return animationFinishedThisTick
 }
 
+// Collects the events of the current frame
+func (a *Animation) enterFrame() {
+	a.frameEntered = true
+	start := len(a.firedEvents)
+	for event, frame := range a.Events {
+		if frame == a.CurrentFrame {
+			a.firedEvents = append(a.firedEvents, event)
+		}
+	}
+	// Several events on the same frame always fire in the same order
+	slices.Sort(a.firedEvents[start:])
+}
+
+// FiredEvents returns the events of the frames that were reached during
+// the last Update. The slice is reused by the next Update.
+func (a *Animation) FiredEvents() []string {
+	return a.firedEvents
+}
+
+// AddEvent lets the event fire every time the frame is shown.
+func (a *Animation) AddEvent(frame int, event string) error {
+	if frame < 0 || frame >= a.FrameAmount {
+		return fmt.Errorf("%w: the frame %d of the event '%s' does not exist", ErrInvalidParameter, frame, event)
+	}
+	// Copy the events so clones of the animation keep their own events
+	events := make(map[string]int, len(a.Events)+1)
+	maps.Copy(events, a.Events)
+	events[event] = frame
+	a.Events = events
+	return nil
+}
+
 // GetImage returns the sub-image corresponding to the CurrentFrame of the animation.
 // It calculates the correct rectangle within the SourceImage based on the animation's
 // properties and the CurrentFrame.
 // Returns nil if the calculated frame rectangle is outside the bounds of the SourceImage.
 func (a *Animation) GetImage() *ebiten.Image {
+	// The spritesheet can be a sub-image of a texture atlas, so the
+	// positions are relative to its top left corner.
+	origin := a.SourceImage.Bounds().Min
 	// Calculate the X position of the current frame on the spritesheet.
 	// Assumes frames are laid out horizontally.
-	sx := a.SourceStartX + a.CurrentFrame*a.FrameWidth
+	sx := origin.X + a.SourceStartX + a.CurrentFrame*a.FrameWidth
 	// Y position is constant for this animation sequence.
-	sy := a.SourceStartY
+	sy := origin.Y + a.SourceStartY
 
 	// Define the rectangle for the current frame within the source image.
 	frameRect := image.Rect(sx, sy, sx+a.FrameWidth, sy+a.FrameHeight)
@@ -172,6 +226,16 @@ func (a *Animation) Reset() {
 	a.CurrentFrame = 0
 	a.FrameTimer = 0
 	a.hasFinished = false
+	a.frameEntered = false
+}
+
+// Clone returns a copy of the animation that starts at its first frame.
+// The source image and the events are shared with the original.
+func (a *Animation) Clone() *Animation {
+	clone := *a
+	clone.firedEvents = nil
+	clone.Reset()
+	return &clone
 }
 
 // IsFinished returns true if a non-looping animation has reached its end.
@@ -397,3 +397,21 @@ func TestAnimation_IsFinished(t *testing.T) {
 		}
 	})
 }
+
+func TestGetImageFromSubImageSheet(t *testing.T) {
+	// Sheets packed into a texture atlas are sub-images that do not start at 0, 0
+	page := newTestSourceImage(200, 200)
+	sheet := page.SubImage(image.Rect(100, 50, 140, 70)).(*ebiten.Image)
+	anim, err := animation.NewAnimation(sheet, 10, 10, 0, 10, 4, 1, true)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	anim.CurrentFrame = 3
+	frame := anim.GetImage()
+	if frame == nil {
+		t.Fatal("Expected a frame image")
+	}
+	if want := image.Rect(130, 60, 140, 70); frame.Bounds() != want {
+		t.Errorf("Expected the frame %v, got %v", want, frame.Bounds())
+	}
+}
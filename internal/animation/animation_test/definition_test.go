@@ -0,0 +1,177 @@
+package animation_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/N3moAhead/harvest/internal/animation"
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+const testDefinitions = `{
+	"carrot": {
+		"sheet": "carrot",
+		"frame_width": 32,
+		"frame_height": 32,
+		"default": "spawn",
+		"animations": {
+			"spawn": {"row": 0, "frames": 4, "speed": 5},
+			"run": {"row": 1, "frames": 4, "speed": 5, "loops": true},
+			"attack": {"row": 2, "frames": 4, "speed": 5, "events": {"hit": 2}},
+			"icon": {"sheet": "icon", "frame_width": 16, "frame_height": 16, "row": 0, "frames": 1, "speed": 1}
+		}
+	}
+}`
+
+// testImages returns a source with a 128x96 "carrot" sheet and a 16x16 "icon"
+func testImages() animation.ImageSource {
+	images := map[string]*ebiten.Image{
+		"carrot": newTestSourceImage(128, 96),
+		"icon":   newTestSourceImage(16, 16),
+	}
+	return func(name string) (*ebiten.Image, bool) {
+		img, ok := images[name]
+		return img, ok
+	}
+}
+
+func TestParseDefinitions(t *testing.T) {
+	definitions, err := animation.ParseDefinitions([]byte(testDefinitions))
+	if err != nil {
+		t.Fatalf("Expected valid definitions, got %v", err)
+	}
+	set, ok := definitions["carrot"]
+	if !ok {
+		t.Fatal("Expected the set 'carrot'")
+	}
+	if set.Default != "spawn" || len(set.Animations) != 4 {
+		t.Errorf("Unexpected set: %+v", set)
+	}
+	if hit := set.Animations["attack"].Events["hit"]; hit != 2 {
+		t.Errorf("Expected the hit event on frame 2, got %d", hit)
+	}
+}
+
+func TestParseDefinitionsInvalid(t *testing.T) {
+	testCases := []struct {
+		name string
+		data string
+		want []string
+	}{
+		{"InvalidJSON", `{`, []string{"failed to parse"}},
+		{"NoAnimations", `{"a": {"sheet": "s", "frame_width": 1, "frame_height": 1, "animations": {}}}`, []string{"'a' has no animations"}},
+		{"UnknownDefault", `{"a": {"sheet": "s", "frame_width": 1, "frame_height": 1, "default": "x", "animations": {"idle": {"frames": 1, "speed": 1}}}}`, []string{"unknown default animation 'x'"}},
+		{"NoSheet", `{"a": {"frame_width": 1, "frame_height": 1, "animations": {"idle": {"frames": 1, "speed": 1}}}}`, []string{"has no sprite sheet"}},
+		{"NoFrameSize", `{"a": {"sheet": "s", "animations": {"idle": {"frames": 1, "speed": 1}}}}`, []string{"invalid frame size 0x0"}},
+		{"EventOutOfRange", `{"a": {"sheet": "s", "frame_width": 1, "frame_height": 1, "animations": {"idle": {"frames": 2, "speed": 1, "events": {"hit": 2}}}}}`, []string{"event 'hit' on frame 2"}},
+		{
+			"AllErrorsTogether",
+			`{"a": {"sheet": "s", "frame_width": 1, "frame_height": 1, "animations": {"idle": {"frames": 0, "speed": 0}}}}`,
+			[]string{"at least one frame", "speed above 0"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			definitions, err := animation.ParseDefinitions([]byte(tc.data))
+			if err == nil {
+				t.Fatalf("Expected an error, got %+v", definitions)
+			}
+			for _, want := range tc.want {
+				if !strings.Contains(err.Error(), want) {
+					t.Errorf("Expected the error to contain '%s', got '%v'", want, err)
+				}
+			}
+		})
+	}
+}
+
+func TestDefinitionsBuild(t *testing.T) {
+	definitions, err := animation.ParseDefinitions([]byte(testDefinitions))
+	if err != nil {
+		t.Fatalf("Expected valid definitions, got %v", err)
+	}
+	templates, err := definitions.Build(testImages())
+	if err != nil {
+		t.Fatalf("Expected no build error, got %v", err)
+	}
+	store, ok := templates["carrot"]
+	if !ok {
+		t.Fatal("Expected a template for 'carrot'")
+	}
+	if store.GetCurrentAnimationName() != "spawn" {
+		t.Errorf("Expected the default animation 'spawn', got '%s'", store.GetCurrentAnimationName())
+	}
+
+	run, _ := store.GetAnimation("run")
+	if run == nil || !run.Loops || run.FrameAmount != 4 || run.AnimationSpeed != 5 {
+		t.Errorf("Unexpected run animation: %+v", run)
+	}
+	attack, _ := store.GetAnimation("attack")
+	if attack == nil || attack.Events["hit"] != 2 {
+		t.Errorf("Expected the attack animation to keep its events, got %+v", attack)
+	}
+	icon, _ := store.GetAnimation("icon")
+	if icon == nil || icon.FrameWidth != 16 {
+		t.Errorf("Expected the icon to use its own sheet and frame size, got %+v", icon)
+	}
+}
+
+func TestDefinitionsBuildErrors(t *testing.T) {
+	definitions, err := animation.ParseDefinitions([]byte(`{
+		"a": {"sheet": "carrot", "frame_width": 32, "frame_height": 32, "animations": {
+			"tooWide": {"row": 0, "frames": 5, "speed": 1},
+			"tooLow": {"row": 3, "frames": 1, "speed": 1},
+			"missing": {"sheet": "nope", "frames": 1, "speed": 1},
+			"fine": {"row": 2, "frames": 4, "speed": 1}
+		}}
+	}`))
+	if err != nil {
+		t.Fatalf("Expected valid definitions, got %v", err)
+	}
+	templates, err := definitions.Build(testImages())
+	if err == nil {
+		t.Fatal("Expected build errors")
+	}
+	for _, want := range []string{"'tooWide'", "'tooLow'", "'nope' does not exist"} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("Expected the error to contain '%s', got '%v'", want, err)
+		}
+	}
+	// The valid animations are still built
+	if _, ok := templates["a"].GetAnimation("fine"); !ok {
+		t.Error("Expected the valid animation to be built")
+	}
+}
+
+func TestAnimationStoreClone(t *testing.T) {
+	definitions, _ := animation.ParseDefinitions([]byte(testDefinitions))
+	templates, err := definitions.Build(testImages())
+	if err != nil {
+		t.Fatalf("Expected no build error, got %v", err)
+	}
+	template := templates["carrot"]
+	template.SetCurrentAnimation("run")
+
+	clone := template.Clone()
+	if clone.GetCurrentAnimationName() != "run" {
+		t.Errorf("Expected the clone to keep the current animation, got '%s'", clone.GetCurrentAnimationName())
+	}
+
+	// Updating the clone must not move the template
+	for range 5 {
+		clone.Update()
+	}
+	if clone.GetCurrentAnimation().CurrentFrame != 1 {
+		t.Errorf("Expected the clone to be on frame 1, got %d", clone.GetCurrentAnimation().CurrentFrame)
+	}
+	if template.GetCurrentAnimation().CurrentFrame != 0 {
+		t.Errorf("Expected the template to stay on frame 0, got %d", template.GetCurrentAnimation().CurrentFrame)
+	}
+
+	// Switching the animation of the clone must not switch the template
+	clone.SetCurrentAnimation("attack")
+	if template.GetCurrentAnimationName() != "run" {
+		t.Errorf("Expected the template to stay on 'run', got '%s'", template.GetCurrentAnimationName())
+	}
+}
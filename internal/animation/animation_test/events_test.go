@@ -0,0 +1,191 @@
+package animation_test
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/N3moAhead/harvest/internal/animation"
+)
+
+func TestAnimationFiresEventsOnTheirFrame(t *testing.T) {
+	anim, err := newTestAnimation(4, 2, false, 10, 10)
+	if err != nil {
+		t.Fatalf("Failed to create the animation: %v", err)
+	}
+	if err := anim.AddEvent(0, "start"); err != nil {
+		t.Fatal(err)
+	}
+	if err := anim.AddEvent(2, "hit"); err != nil {
+		t.Fatal(err)
+	}
+	if err := anim.AddEvent(2, "footstep"); err != nil {
+		t.Fatal(err)
+	}
+
+	// Frame 0 is shown right away, frame 2 after 4 ticks
+	fired := map[int][]string{}
+	for tick := 1; tick <= 10; tick++ {
+		anim.Update()
+		if events := anim.FiredEvents(); len(events) > 0 {
+			fired[tick] = slices.Clone(events)
+		}
+	}
+	if len(fired) != 2 {
+		t.Fatalf("Expected events on 2 ticks, got %v", fired)
+	}
+	if !slices.Equal(fired[1], []string{"start"}) {
+		t.Errorf("Expected 'start' on the first tick, got %v", fired[1])
+	}
+	if !slices.Equal(fired[4], []string{"footstep", "hit"}) {
+		t.Errorf("Expected 'footstep' and 'hit' on tick 4, got %v", fired[4])
+	}
+}
+
+func TestAnimationEventsRepeatWhenLooping(t *testing.T) {
+	anim, _ := newTestAnimation(2, 1, true, 10, 10)
+	anim.AddEvent(0, "footstep")
+
+	count := 0
+	for range 6 {
+		anim.Update()
+		count += len(anim.FiredEvents())
+	}
+	// Frame 0 is shown on the ticks 1, 2, 4 and 6
+	if count != 4 {
+		t.Errorf("Expected 4 footsteps, got %d", count)
+	}
+}
+
+func TestAnimationEventsFireAgainAfterReset(t *testing.T) {
+	anim, _ := newTestAnimation(2, 1, false, 10, 10)
+	anim.AddEvent(0, "start")
+
+	anim.Update()
+	anim.Update()
+	anim.Update()
+	if len(anim.FiredEvents()) != 0 {
+		t.Errorf("Expected no events after the animation finished, got %v", anim.FiredEvents())
+	}
+
+	anim.Reset()
+	anim.Update()
+	if !slices.Equal(anim.FiredEvents(), []string{"start"}) {
+		t.Errorf("Expected 'start' after the reset, got %v", anim.FiredEvents())
+	}
+}
+
+func TestAddEventInvalidFrame(t *testing.T) {
+	anim, _ := newTestAnimation(3, 1, false, 10, 10)
+	for _, frame := range []int{-1, 3} {
+		if err := anim.AddEvent(frame, "hit"); !errors.Is(err, animation.ErrInvalidParameter) {
+			t.Errorf("Expected ErrInvalidParameter for frame %d, got %v", frame, err)
+		}
+	}
+}
+
+func TestAddEventDoesNotChangeClones(t *testing.T) {
+	anim, _ := newTestAnimation(3, 1, false, 10, 10)
+	anim.AddEvent(1, "hit")
+	clone := anim.Clone()
+	clone.AddEvent(2, "sfx:spoon_slash")
+
+	if _, ok := anim.Events["sfx:spoon_slash"]; ok {
+		t.Error("Expected the event of the clone to stay on the clone")
+	}
+	if clone.Events["hit"] != 1 {
+		t.Error("Expected the clone to keep the events of the original")
+	}
+}
+
+func TestAnimationStoreDispatchesEvents(t *testing.T) {
+	store := animation.NewAnimationStore()
+	attack, _ := newTestAnimation(4, 1, false, 10, 10)
+	attack.AddEvent(2, "hit")
+	attack.AddEvent(3, "sfx:swing")
+	walk, _ := newTestAnimation(4, 1, true, 10, 10)
+	walk.AddEvent(2, "hit")
+	store.AddAnimation("attack", attack)
+	store.AddAnimation("walk", walk)
+
+	var hits []string
+	var all []string
+	store.OnEvent("hit", func(event, animationName string) {
+		hits = append(hits, animationName)
+	})
+	store.OnAnyEvent(func(event, animationName string) {
+		all = append(all, event)
+	})
+
+	store.SetCurrentAnimation("attack")
+	for range 5 {
+		store.Update()
+	}
+	if !slices.Equal(hits, []string{"attack"}) {
+		t.Errorf("Expected one hit of the attack, got %v", hits)
+	}
+	if !slices.Equal(all, []string{"hit", "sfx:swing"}) {
+		t.Errorf("Expected every event in order, got %v", all)
+	}
+
+	// Only the current animation fires events
+	if walk.CurrentFrame != 0 {
+		t.Errorf("Expected the walk animation to stay untouched, got frame %d", walk.CurrentFrame)
+	}
+}
+
+func TestAnimationStoreRestart(t *testing.T) {
+	store := animation.NewAnimationStore()
+	slash, _ := newTestAnimation(2, 1, false, 10, 10)
+	slash.AddEvent(0, "sfx:slash")
+	store.AddAnimation("slash", slash)
+
+	sounds := 0
+	store.OnEvent("sfx:slash", func(string, string) { sounds++ })
+
+	store.SetCurrentAnimation("slash")
+	for range 3 {
+		store.Update()
+	}
+	if !slash.IsFinished() {
+		t.Fatal("Expected the slash to be finished")
+	}
+
+	// SetCurrentAnimation keeps a finished animation, Restart plays it again
+	store.SetCurrentAnimation("slash")
+	store.Update()
+	if sounds != 1 {
+		t.Errorf("Expected 1 sound before the restart, got %d", sounds)
+	}
+	if !store.Restart("slash") || slash.IsFinished() {
+		t.Fatal("Expected the slash to restart")
+	}
+	store.Update()
+	if sounds != 2 {
+		t.Errorf("Expected 2 sounds after the restart, got %d", sounds)
+	}
+	if store.Restart("missing") {
+		t.Error("Expected Restart to fail for a missing animation")
+	}
+}
+
+func TestAnimationStoreCloneDropsHandlers(t *testing.T) {
+	store := animation.NewAnimationStore()
+	anim, _ := newTestAnimation(2, 2, true, 10, 10)
+	anim.AddEvent(0, "hit")
+	store.AddAnimation("idle", anim)
+	store.SetCurrentAnimation("idle")
+
+	calls := 0
+	store.OnAnyEvent(func(string, string) { calls++ })
+
+	clone := store.Clone()
+	clone.Update()
+	if calls != 0 {
+		t.Errorf("Expected the clone to have no handlers, got %d calls", calls)
+	}
+	store.Update()
+	if calls != 1 {
+		t.Errorf("Expected the handler of the original to be called once, got %d", calls)
+	}
+}
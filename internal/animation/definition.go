@@ -0,0 +1,162 @@
+package animation
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"image"
+	"slices"
+
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+// Definition describes a single animation on a sprite sheet. The sheet
+// and the frame size can be left out to use the values of the set.
+// All frames of an animation are in the same row of the sheet.
+type Definition struct {
+	Sheet       string         `json:"sheet,omitempty"`
+	FrameWidth  int            `json:"frame_width,omitempty"`
+	FrameHeight int            `json:"frame_height,omitempty"`
+	Column      int            `json:"column,omitempty"` // The column of the first frame
+	Row         int            `json:"row"`
+	Frames      int            `json:"frames"`
+	Speed       int            `json:"speed"` // The amount of ticks each frame is shown
+	Loops       bool           `json:"loops,omitempty"`
+	Events      map[string]int `json:"events,omitempty"` // Maps the name of an event to the frame it happens on
+}
+
+// SetDefinition describes all animations of an entity
+type SetDefinition struct {
+	Sheet       string                `json:"sheet"` // The name of the sprite sheet in the asset store
+	FrameWidth  int                   `json:"frame_width"`
+	FrameHeight int                   `json:"frame_height"`
+	Default     string                `json:"default,omitempty"` // The animation that is active after cloning
+	Animations  map[string]Definition `json:"animations"`
+}
+
+// Definitions maps the name of an animation set to its definition
+type Definitions map[string]SetDefinition
+
+// ImageSource returns the sprite sheet with the given name
+type ImageSource func(name string) (*ebiten.Image, bool)
+
+// ParseDefinitions parses and validates animation definitions.
+// All problems are returned together.
+func ParseDefinitions(data []byte) (Definitions, error) {
+	var definitions Definitions
+	if err := json.Unmarshal(data, &definitions); err != nil {
+		return nil, fmt.Errorf("failed to parse the animation definitions: %w", err)
+	}
+	if err := definitions.validate(); err != nil {
+		return nil, err
+	}
+	return definitions, nil
+}
+
+// Returns the sorted keys of a map so errors are always in the same order
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+	return keys
+}
+
+func (d Definitions) validate() error {
+	var errs []error
+	for _, setName := range sortedKeys(d) {
+		set := d[setName]
+		if len(set.Animations) == 0 {
+			errs = append(errs, fmt.Errorf("animation set '%s' has no animations", setName))
+		}
+		if _, ok := set.Animations[set.Default]; set.Default != "" && !ok {
+			errs = append(errs, fmt.Errorf("animation set '%s' has the unknown default animation '%s'", setName, set.Default))
+		}
+		for _, name := range sortedKeys(set.Animations) {
+			def := set.resolve(set.Animations[name])
+			prefix := fmt.Sprintf("animation '%s' of '%s'", name, setName)
+			if def.Sheet == "" {
+				errs = append(errs, fmt.Errorf("%s has no sprite sheet", prefix))
+			}
+			if def.FrameWidth <= 0 || def.FrameHeight <= 0 {
+				errs = append(errs, fmt.Errorf("%s has the invalid frame size %dx%d", prefix, def.FrameWidth, def.FrameHeight))
+			}
+			if def.Column < 0 || def.Row < 0 {
+				errs = append(errs, fmt.Errorf("%s starts at the invalid position %d, %d", prefix, def.Column, def.Row))
+			}
+			if def.Frames <= 0 {
+				errs = append(errs, fmt.Errorf("%s needs at least one frame", prefix))
+			}
+			if def.Speed <= 0 {
+				errs = append(errs, fmt.Errorf("%s needs a speed above 0", prefix))
+			}
+			for _, event := range sortedKeys(def.Events) {
+				if frame := def.Events[event]; frame < 0 || frame >= def.Frames {
+					errs = append(errs, fmt.Errorf("%s has the event '%s' on frame %d but only %d frames", prefix, event, frame, def.Frames))
+				}
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// Fills the values the animation left out with the values of the set
+func (s SetDefinition) resolve(def Definition) Definition {
+	if def.Sheet == "" {
+		def.Sheet = s.Sheet
+	}
+	if def.FrameWidth == 0 {
+		def.FrameWidth = s.FrameWidth
+	}
+	if def.FrameHeight == 0 {
+		def.FrameHeight = s.FrameHeight
+	}
+	return def
+}
+
+// Build creates a template store for every animation set. Entities get
+// their own copy of a template with Clone. Missing sprite sheets and
+// frames outside of their sheet are returned as errors.
+func (d Definitions) Build(images ImageSource) (map[string]*AnimationStore, error) {
+	var errs []error
+	templates := make(map[string]*AnimationStore, len(d))
+	for _, setName := range sortedKeys(d) {
+		set := d[setName]
+		store := NewAnimationStore()
+		for _, name := range sortedKeys(set.Animations) {
+			anim, err := set.build(set.resolve(set.Animations[name]), images)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("animation '%s' of '%s': %w", name, setName, err))
+				continue
+			}
+			store.AddAnimation(name, anim)
+		}
+		if set.Default != "" {
+			store.SetCurrentAnimation(set.Default)
+		}
+		templates[setName] = store
+	}
+	return templates, errors.Join(errs...)
+}
+
+func (s SetDefinition) build(def Definition, images ImageSource) (*Animation, error) {
+	sheet, ok := images(def.Sheet)
+	if !ok {
+		return nil, fmt.Errorf("the sprite sheet '%s' does not exist", def.Sheet)
+	}
+	x := def.Column * def.FrameWidth
+	y := def.Row * def.FrameHeight
+	// The last frame has to be inside of the sheet as well
+	frames := image.Rect(x, y, x+def.Frames*def.FrameWidth, y+def.FrameHeight)
+	// Sheets packed into an atlas do not start at 0, 0
+	if !frames.Add(sheet.Bounds().Min).In(sheet.Bounds()) {
+		return nil, fmt.Errorf("the frames %v are outside of the sprite sheet '%s' of the size %v", frames, def.Sheet, sheet.Bounds().Size())
+	}
+	anim, err := NewAnimation(sheet, def.FrameWidth, def.FrameHeight, x, y, def.Frames, def.Speed, def.Loops)
+	if err != nil {
+		return nil, err
+	}
+	anim.Events = def.Events
+	return anim, nil
+}
@@ -13,6 +13,17 @@ type AnimationStore struct {
 	Animations map[string]*Animation
 	// currentAnimationName holds the key of the currently active animation within the store.
 	currentAnimationName string
+	// handlers are called with the events of the current animation.
+	handlers []eventHandler
+}
+
+// EventHandler is called when an animation reaches the frame of an event.
+// It gets the name of the event and the name of the animation.
+type EventHandler func(event, animationName string)
+
+type eventHandler struct {
+	event  string // Empty for handlers that receive every event
+	handle EventHandler
 }
 
 // NewAnimationStore creates a new, empty AnimationStore.
@@ -57,6 +68,19 @@ func (as *AnimationStore) SetCurrentAnimation(name string) bool {
 	return true
 }
 
+// Restart sets the current animation and starts it from its first frame,
+// even if it already is the current animation.
+// Returns false if the name does not exist in the store.
+func (as *AnimationStore) Restart(name string) bool {
+	anim, ok := as.Animations[name]
+	if !ok {
+		return false
+	}
+	anim.Reset()
+	as.currentAnimationName = name
+	return true
+}
+
 // GetCurrentAnimation returns the currently active Animation instance.
 // Returns nil if no animation has been set using SetCurrentAnimation or
 // if the stored currentAnimationName is no longer valid (e.g., animation removed).
@@ -87,12 +111,33 @@ func (as *AnimationStore) GetAnimation(name string) (*Animation, bool) {
 // using GetCurrentAnimation() and call Update() on it directly.
 // Does nothing if no current animation is set.
 // Returns the result of the underlying Animation's Update call (true if it finished a cycle).
+// The events the current animation reaches are passed to the registered handlers.
 func (as *AnimationStore) Update() bool {
 	currentAnim := as.GetCurrentAnimation()
-	if currentAnim != nil {
-		return currentAnim.Update()
+	if currentAnim == nil {
+		return false // No active animation to update
+	}
+	finished := currentAnim.Update()
+	name := as.currentAnimationName
+	for _, event := range currentAnim.FiredEvents() {
+		for _, handler := range as.handlers {
+			if handler.event == "" || handler.event == event {
+				handler.handle(event, name)
+			}
+		}
 	}
-	return false // No active animation to update
+	return finished
+}
+
+// OnEvent registers a handler that is called every time the current
+// animation reaches the frame of the given event.
+func (as *AnimationStore) OnEvent(event string, handler EventHandler) {
+	as.handlers = append(as.handlers, eventHandler{event: event, handle: handler})
+}
+
+// OnAnyEvent registers a handler that is called for every event of the current animation.
+func (as *AnimationStore) OnAnyEvent(handler EventHandler) {
+	as.handlers = append(as.handlers, eventHandler{handle: handler})
 }
 
 // GetImage returns the current frame image of the currently active animation.
@@ -106,6 +151,25 @@ func (as *AnimationStore) GetImage() *ebiten.Image {
 	return nil // No active animation
 }
 
+// Clone returns a copy of the store with its own copies of the animations,
+// so entities created from the same template do not share their frames.
+// Animations added under several names stay shared within the copy.
+// The event handlers are not copied.
+func (as *AnimationStore) Clone() *AnimationStore {
+	clone := NewAnimationStore()
+	clones := make(map[*Animation]*Animation, len(as.Animations))
+	for name, anim := range as.Animations {
+		animClone, ok := clones[anim]
+		if !ok {
+			animClone = anim.Clone()
+			clones[anim] = animClone
+		}
+		clone.Animations[name] = animClone
+	}
+	clone.currentAnimationName = as.currentAnimationName
+	return clone
+}
+
 func (as *AnimationStore) GetCurrentAnimationName() string {
 	return as.currentAnimationName
 }
@@ -0,0 +1,109 @@
+package assets_test
+
+import (
+	"errors"
+	"io/fs"
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	gamedata "github.com/N3moAhead/harvest/assets"
+	"github.com/N3moAhead/harvest/internal/assets"
+)
+
+func TestEmbeddedManifestIsValid(t *testing.T) {
+	manifest, err := assets.LoadManifest(gamedata.Files)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, entry := range manifest.Assets {
+		// Music files are not part of the repository
+		if entry.Type == assets.MusicAsset {
+			continue
+		}
+		if _, err := fs.Stat(gamedata.Files, entry.Path); err != nil {
+			t.Errorf("The file of '%s' is missing: %v", entry.Name, err)
+		}
+	}
+}
+
+func TestParseManifestReturnsAllErrors(t *testing.T) {
+	_, err := assets.ParseManifest([]byte(`{"assets": [
+		{"name": "a", "type": "image", "path": "a.png"},
+		{"name": "a", "type": "image", "path": "b.png"},
+		{"name": "font", "type": "font", "path": "font.ttf"},
+		{"name": "b", "type": "video", "path": "b.mp4"}
+	]}`))
+	if err == nil {
+		t.Fatal("Expected an error")
+	}
+	for _, want := range []string{"more than once", "has no size", "unknown type"} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("Expected the error to contain %q, got %v", want, err)
+		}
+	}
+}
+
+func TestFilterKeepsMatchingEntries(t *testing.T) {
+	manifest, err := assets.ParseManifest([]byte(`{"assets": [
+		{"name": "font", "type": "font", "path": "font.ttf", "size": 12, "preload": true},
+		{"name": "a", "type": "image", "path": "a.png"}
+	]}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	preload := manifest.Filter(func(entry assets.ManifestEntry) bool { return entry.Preload })
+	if len(preload) != 1 || preload[0].Name != "font" {
+		t.Errorf("Expected only the font, got %v", preload)
+	}
+}
+
+func TestOverlayPrefersOverrides(t *testing.T) {
+	base := fstest.MapFS{
+		"images/a.png": {Data: []byte("base a")},
+		"images/b.png": {Data: []byte("base b")},
+	}
+	override := fstest.MapFS{
+		"images/a.png": {Data: []byte("mod a")},
+	}
+	overlay := assets.NewOverlayFS(override, base)
+
+	for name, want := range map[string]string{"images/a.png": "mod a", "images/b.png": "base b"} {
+		data, err := fs.ReadFile(overlay, name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(data) != want {
+			t.Errorf("Expected %q for %s, got %q", want, name, data)
+		}
+	}
+	if _, err := overlay.Open("images/c.png"); !errors.Is(err, fs.ErrNotExist) {
+		t.Errorf("Expected fs.ErrNotExist for a missing file, got %v", err)
+	}
+}
+
+func TestEmbeddedAnimationsBuild(t *testing.T) {
+	manifest, err := assets.LoadManifest(gamedata.Files)
+	if err != nil {
+		t.Fatal(err)
+	}
+	entries := manifest.Filter(func(entry assets.ManifestEntry) bool {
+		return entry.Type == assets.ImageAsset || entry.Type == assets.AnimationAsset
+	})
+	store := assets.NewStore()
+	if err := store.Load(gamedata.Files, entries, 44100); err != nil {
+		t.Fatal(err)
+	}
+	// The images and the tiles cut from the sheets are packed into atlases
+	if store.AtlasPages() == 0 {
+		t.Error("Expected the images to be packed into an atlas")
+	}
+	if tile, ok := store.GetImage("td_decor_0_0"); !ok || tile.Bounds().Dx() != 16 {
+		t.Errorf("Expected the decor tile to keep its size, got %v", tile)
+	}
+	for _, name := range []string{"player", "carrot", "cook_station"} {
+		if _, ok := store.GetAnimations(name); !ok {
+			t.Errorf("Expected the animations of '%s'", name)
+		}
+	}
+}
@@ -0,0 +1,85 @@
+package assets
+
+import (
+	"fmt"
+
+	"github.com/N3moAhead/harvest/internal/audio"
+	"github.com/N3moAhead/harvest/internal/settings"
+)
+
+// How the sound effects are played. Sounds that are missing here
+// use the default options of the audio manager.
+var soundOptions = map[string]audio.SoundOptions{
+	"spoon_slash":        {PitchJitter: 0.08, VolumeJitter: 0.1, MaxVoices: 3},
+	"rolling_pin_roll":   {PitchJitter: 0.05, VolumeJitter: 0.1, MaxVoices: 3},
+	"thermalmixer_slash": {PitchJitter: 0.08, VolumeJitter: 0.1, MaxVoices: 3},
+	"knife_throw":        {PitchJitter: 0.1, VolumeJitter: 0.15, MaxVoices: 4},
+	"knife_throw_impact": {PitchJitter: 0.15, VolumeJitter: 0.2, MaxVoices: 6},
+	"player_hit_sound":   {PitchJitter: 0.06, VolumeJitter: 0.1, MaxVoices: 2},
+	"player_death_sound": {MaxVoices: 1},
+	"veggienated":        {MaxVoices: 1},
+	"game_loads_sound":   {MaxVoices: 1},
+}
+
+// The sounds that were already created. Every sound is only created
+// once so the limit of voices counts for the whole game.
+var sounds = map[string]*audio.Sound{}
+
+// GetSound returns the sound effect with the given name
+func GetSound(name string) (*audio.Sound, bool) {
+	if sound, ok := sounds[name]; ok {
+		return sound, true
+	}
+	data, ok := AssetStore.GetSFXData(name)
+	if !ok {
+		return nil, false
+	}
+	sound := Audio.NewSound(data, soundOptions[name])
+	sounds[name] = sound
+	return sound, true
+}
+
+// PlaySound plays the sound effect with the given name
+func PlaySound(name string) {
+	sound, ok := GetSound(name)
+	if !ok {
+		fmt.Println("Warning: Could not find sound", name)
+		return
+	}
+	sound.Play()
+}
+
+// The music tracks played by each scene. The tracks are played
+// in order and the playlist starts again after the last track.
+var playlists = map[string][]string{
+	"menu": {"menu"},
+	"game": {"game"},
+}
+
+// PlayMusic crossfades to the playlist with the given name. Nothing
+// happens if the playlist is already playing.
+func PlayMusic(playlist string) {
+	if Audio.IsMusicPlaying(playlist) {
+		return
+	}
+	tracks, ok := playlists[playlist]
+	if !ok {
+		fmt.Println("Warning: Could not find playlist", playlist)
+		return
+	}
+	for _, track := range tracks {
+		if !AssetStore.HasMusic(track) {
+			fmt.Println("Warning: Could not find music", track)
+			return
+		}
+	}
+	Audio.PlayMusic(playlist, audio.NewPlaylist(AssetStore.OpenMusic, tracks...))
+}
+
+// ApplyVolumes sets the volumes of the audio buses to the current settings
+func ApplyVolumes() {
+	s := settings.Current()
+	Audio.Master.SetVolume(s.MasterVolume)
+	Audio.Music.SetVolume(s.MusicVolume)
+	Audio.SFX.SetVolume(s.SFXVolume)
+}
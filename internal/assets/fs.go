@@ -0,0 +1,51 @@
+package assets
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+
+	gamedata "github.com/N3moAhead/harvest/assets"
+	"github.com/N3moAhead/harvest/internal/config"
+)
+
+// FS holds all asset files of the game. Files in the override
+// directory replace the embedded files with the same path.
+var FS fs.FS = newAssetFS()
+
+func newAssetFS() fs.FS {
+	info, err := os.Stat(config.ASSET_OVERRIDE_DIR)
+	if err != nil || !info.IsDir() {
+		return gamedata.Files
+	}
+	fmt.Println("Using asset overrides from", config.ASSET_OVERRIDE_DIR)
+	return NewOverlayFS(os.DirFS(config.ASSET_OVERRIDE_DIR), gamedata.Files)
+}
+
+// An OverlayFS opens files from the override file system
+// and falls back to the base file system for missing files.
+type OverlayFS struct {
+	override fs.FS
+	base     fs.FS
+}
+
+func NewOverlayFS(override, base fs.FS) *OverlayFS {
+	return &OverlayFS{
+		override: override,
+		base:     base,
+	}
+}
+
+func (o *OverlayFS) Open(name string) (fs.File, error) {
+	f, err := o.override.Open(name)
+	if err == nil {
+		return f, nil
+	}
+	if !errors.Is(err, fs.ErrNotExist) {
+		return nil, err
+	}
+	return o.base.Open(name)
+}
+
+var _ fs.FS = (*OverlayFS)(nil)
@@ -1,34 +1,48 @@
 package assets
 
 import (
+	"cmp"
+	"errors"
 	"fmt"
+	"image"
+	_ "image/png"
 	"io"
-	"os"
-	"path/filepath"
+	"io/fs"
+	"path"
+	"slices"
 	"strings"
 
+	"github.com/N3moAhead/harvest/internal/animation"
+	"github.com/N3moAhead/harvest/internal/atlas"
+	"github.com/N3moAhead/harvest/internal/config"
+	"github.com/N3moAhead/harvest/pkg/util"
 	"github.com/hajimehoshi/ebiten/v2"
 	"github.com/hajimehoshi/ebiten/v2/audio/mp3"
 	"github.com/hajimehoshi/ebiten/v2/audio/vorbis"
 	"github.com/hajimehoshi/ebiten/v2/audio/wav"
-	"github.com/hajimehoshi/ebiten/v2/ebitenutil"
 	"golang.org/x/image/font"
 	"golang.org/x/image/font/opentype"
 )
 
 type Store struct {
-	images map[string]*ebiten.Image
-	sfx    map[string][]byte
-	music  map[string][]byte
-	fonts  map[string]font.Face
+	images          map[string]*ebiten.Image
+	sfx             map[string][]byte
+	music           map[string]string // Music is streamed while playing so only the path is stored
+	fonts           map[string]font.Face
+	animations      map[string]*animation.AnimationStore // Templates that are cloned for every entity
+	fsys            fs.FS                                // The files music is streamed from
+	musicSampleRate int
+	atlases         []*atlas.Atlas
+	unpacked        []string // Images that are not in an atlas yet
 }
 
 func NewStore() *Store {
 	return &Store{
-		images: make(map[string]*ebiten.Image),
-		sfx:    make(map[string][]byte),
-		music:  make(map[string][]byte),
-		fonts:  make(map[string]font.Face),
+		images:     make(map[string]*ebiten.Image),
+		sfx:        make(map[string][]byte),
+		music:      make(map[string]string),
+		fonts:      make(map[string]font.Face),
+		animations: make(map[string]*animation.AnimationStore),
 	}
 }
 
@@ -36,14 +50,25 @@ func NewStore() *Store {
 
 func (s *Store) addImageToStore(name string, img *ebiten.Image) {
 	s.images[name] = img
+	s.unpacked = append(s.unpacked, name)
 	fmt.Printf("Image added to store: %s\n", name)
 }
 
 // --- GETTERS ---
 
-func (s *Store) GetMusicData(name string) (music []byte, musicFound bool) {
-	music, ok := s.music[name]
-	return music, ok
+func (s *Store) HasMusic(name string) bool {
+	_, ok := s.music[name]
+	return ok
+}
+
+// OpenMusic opens the music file and decodes it while it is read.
+// The stream has to be closed after playing to close the file.
+func (s *Store) OpenMusic(name string) (io.ReadCloser, error) {
+	path, ok := s.music[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown music %s", name)
+	}
+	return openAudioStream(s.fsys, path, s.musicSampleRate)
 }
 
 func (s *Store) GetSFXData(name string) (sfx []byte, sfxFound bool) {
@@ -56,140 +81,313 @@ func (s *Store) GetImage(name string) (image *ebiten.Image, imageFound bool) {
 	return img, ok
 }
 
+// GetAnimations returns a copy of the animation set with the given name.
+// Every entity needs its own copy so the entities do not share their frames.
+// Events like "sfx:spoon_slash" play the sound after the prefix.
+func (s *Store) GetAnimations(name string) (*animation.AnimationStore, bool) {
+	template, ok := s.animations[name]
+	if !ok {
+		return nil, false
+	}
+	store := template.Clone()
+	store.OnAnyEvent(playSoundEvent)
+	return store, true
+}
+
+// The prefix of animation events that play a sound
+const soundEventPrefix = "sfx:"
+
+func playSoundEvent(event, _ string) {
+	if sound, ok := strings.CutPrefix(event, soundEventPrefix); ok {
+		PlaySound(sound)
+	}
+}
+
 func (s *Store) GetFont(name string) (fontFace font.Face, fontFound bool) {
 	fontFace, ok := s.fonts[name]
 	return fontFace, ok
 }
 
-func (s *Store) Load(
-	imageFiles map[string]string,
-	sfxFiles map[string]string,
-	fontFiles map[string]FontConfig,
-	musicFiles map[string]string,
-	audioSampleRate int,
-) error {
-	var err error
-
-	fmt.Println("Loading image files...")
-	for name, path := range imageFiles {
-		if _, exists := s.images[name]; exists {
-			// We will maybe load some graphics before the Load() will be called.
-			// For example to use them on the loading screen or something so they will
-			// be skipped here!
-			continue
-		}
-
-		img, _, loadErr := ebitenutil.NewImageFromFile(path)
-		if loadErr != nil {
-			err = fmt.Errorf("failed to load image %s: %w", name, loadErr)
-			continue
+// Load loads the assets of the entries from the file system. Assets
+// that are already in the store are skipped. Loading goes on after an
+// error so all problems are returned together.
+func (s *Store) Load(fsys fs.FS, entries []ManifestEntry, audioSampleRate int) error {
+	var errs []error
+	s.musicSampleRate = audioSampleRate
+	s.fsys = fsys
+	// Animations are cut from images so they are loaded last
+	entries = slices.Clone(entries)
+	slices.SortStableFunc(entries, func(a, b ManifestEntry) int {
+		return cmp.Compare(boolToInt(a.Type == AnimationAsset), boolToInt(b.Type == AnimationAsset))
+	})
+	packed := false
+	for _, entry := range entries {
+		// The animations are cut from the packed images
+		if entry.Type == AnimationAsset && !packed {
+			s.packImages()
+			packed = true
 		}
-		s.images[name] = img
-		fmt.Printf("Image '%s' loaded: %s\n", name, path)
-	}
-
-	fmt.Println("Loading sfx files...")
-	for name, path := range sfxFiles {
-		if _, exists := s.sfx[name]; exists {
-			continue
+		var err error
+		switch entry.Type {
+		case ImageAsset:
+			err = s.loadImage(fsys, entry)
+		case SFXAsset:
+			err = s.loadSFX(fsys, entry, audioSampleRate)
+		case FontAsset:
+			err = s.loadFont(fsys, entry)
+		case MusicAsset:
+			err = s.loadMusic(fsys, entry)
+		case AnimationAsset:
+			err = s.loadAnimations(fsys, entry)
+		default:
+			err = fmt.Errorf("unknown asset type '%s' of '%s'", entry.Type, entry.Name)
 		}
-		data, loadErr := loadAudioFile(path, audioSampleRate)
-		if loadErr != nil {
-			err = fmt.Errorf("Error while loading the sfx'%s' (%s): %w", name, path, loadErr)
+		if err != nil {
 			fmt.Println(err)
-			continue
+			errs = append(errs, err)
 		}
-		s.sfx[name] = data
-		fmt.Printf("SFX '%s' loaded: %s\n", name, path)
 	}
+	if !packed {
+		s.packImages()
+	}
+	return errors.Join(errs...)
+}
 
-	fmt.Println("Loading font files...")
-	for name, fontConfig := range fontFiles {
-		if _, exists := s.fonts[name]; exists {
-			continue
-		}
-		font, loadErr := loadFontFile(fontConfig.Path, float64(fontConfig.Size), 72)
-		if loadErr != nil {
-			err = fmt.Errorf("Error while loading the font'%s' (%s): %w", name, fontConfig.Path, loadErr)
-			fmt.Println(err)
-			continue
-		}
-		s.fonts[name] = font
-		fmt.Printf("Font '%s' loaded: %s\n", name, fontConfig.Path)
+// Packs the images that were loaded since the last call into a new atlas.
+// GetImage returns the sub-images of the atlas afterwards.
+func (s *Store) packImages() {
+	if len(s.unpacked) == 0 {
+		return
 	}
+	images := make(map[string]*ebiten.Image, len(s.unpacked))
+	for _, name := range s.unpacked {
+		images[name] = s.images[name]
+	}
+	packed := atlas.New(images, config.ATLAS_PAGE_SIZE, config.ATLAS_PADDING)
+	for name := range images {
+		s.images[name], _ = packed.Image(name)
+	}
+	s.atlases = append(s.atlases, packed)
+	s.unpacked = s.unpacked[:0]
+	fmt.Printf("Packed %d images into %d atlas pages\n", len(images), len(packed.Pages()))
+}
+
+// AtlasPages returns the amount of atlas pages the images are packed into
+func (s *Store) AtlasPages() int {
+	pages := 0
+	for _, a := range s.atlases {
+		pages += len(a.Pages())
+	}
+	return pages
+}
+
+func (s *Store) loadImage(fsys fs.FS, entry ManifestEntry) error {
+	if _, exists := s.images[entry.Name]; exists {
+		// We will maybe load some graphics before the Load() will be called.
+		// For example to use them on the loading screen or something so they will
+		// be skipped here!
+		return nil
+	}
+	img, err := loadImageFile(fsys, entry.Path)
+	if err != nil {
+		return fmt.Errorf("failed to load image %s: %w", entry.Name, err)
+	}
+	s.images[entry.Name] = img
+	s.unpacked = append(s.unpacked, entry.Name)
+	fmt.Printf("Image '%s' loaded: %s\n", entry.Name, entry.Path)
+	if entry.Sheet != nil {
+		s.addTilesFromSheet(img, entry.Sheet)
+	}
+	return nil
+}
+
+func (s *Store) loadSFX(fsys fs.FS, entry ManifestEntry, audioSampleRate int) error {
+	if _, exists := s.sfx[entry.Name]; exists {
+		return nil
+	}
+	data, err := loadAudioFile(fsys, entry.Path, audioSampleRate)
+	if err != nil {
+		return fmt.Errorf("Error while loading the sfx'%s' (%s): %w", entry.Name, entry.Path, err)
+	}
+	s.sfx[entry.Name] = data
+	fmt.Printf("SFX '%s' loaded: %s\n", entry.Name, entry.Path)
+	return nil
+}
+
+func (s *Store) loadFont(fsys fs.FS, entry ManifestEntry) error {
+	if _, exists := s.fonts[entry.Name]; exists {
+		return nil
+	}
+	font, err := loadFontFile(fsys, entry.Path, float64(entry.Size), 72)
+	if err != nil {
+		return fmt.Errorf("Error while loading the font'%s' (%s): %w", entry.Name, entry.Path, err)
+	}
+	s.fonts[entry.Name] = font
+	fmt.Printf("Font '%s' loaded: %s\n", entry.Name, entry.Path)
+	return nil
+}
+
+// Music files are only checked here. They are decoded
+// while they are playing because decoding them takes long.
+func (s *Store) loadMusic(fsys fs.FS, entry ManifestEntry) error {
+	if _, exists := s.music[entry.Name]; exists {
+		return nil
+	}
+	if _, err := fs.Stat(fsys, entry.Path); err != nil {
+		return fmt.Errorf("Error while loading the music'%s' (%s): %w", entry.Name, entry.Path, err)
+	}
+	s.music[entry.Name] = entry.Path
+	fmt.Printf("Music '%s' found: %s\n", entry.Name, entry.Path)
+	return nil
+}
 
-	// Loading music is very slow because currently its getting loaded
-	// fully into memory. We should consider to stream parts of the music file only
-	// when we need it to speed up loading times :)
-	// I will just keep it for the moment causing to simplicity XP
-	fmt.Println("Loading music files...")
-	for name, path := range musicFiles {
-		if _, exists := s.music[name]; exists {
+// Creates the animation templates of a definitions file
+func (s *Store) loadAnimations(fsys fs.FS, entry ManifestEntry) error {
+	data, err := fs.ReadFile(fsys, entry.Path)
+	if err != nil {
+		return fmt.Errorf("Error while loading the animations '%s' (%s): %w", entry.Name, entry.Path, err)
+	}
+	definitions, err := animation.ParseDefinitions(data)
+	if err != nil {
+		return fmt.Errorf("Error while loading the animations '%s' (%s): %w", entry.Name, entry.Path, err)
+	}
+	templates, err := definitions.Build(s.GetImage)
+	for name, template := range templates {
+		if _, exists := s.animations[name]; exists {
+			err = errors.Join(err, fmt.Errorf("the animation set '%s' is defined more than once", name))
 			continue
 		}
-		data, loadErr := loadAudioFile(path, audioSampleRate)
-		if loadErr != nil {
-			err = fmt.Errorf("Error while loading the music'%s' (%s): %w", name, path, loadErr)
-			fmt.Println(err)
-			continue
+		s.animations[name] = template
+	}
+	if err != nil {
+		return fmt.Errorf("Error while loading the animations '%s' (%s): %w", entry.Name, entry.Path, err)
+	}
+	fmt.Printf("Animations '%s' loaded: %s\n", entry.Name, entry.Path)
+	return nil
+}
+
+func boolToInt(b bool) int {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+// Adds the tiles of a sprite sheet to the store
+func (s *Store) addTilesFromSheet(img *ebiten.Image, sheet *SpriteSheet) {
+	ts := sheet.TileSize
+	if ts == 0 {
+		ts = config.TILE_SIZE
+	}
+	for row := range sheet.Rows {
+		for col := range sheet.Cols {
+			name := sheet.Prefix + fmt.Sprint(row) + "_" + fmt.Sprint(col)
+			s.addTileFromSprite(name, img, col*ts, row*ts, ts)
 		}
-		s.music[name] = data
-		fmt.Printf("Music '%s' loaded: %s\n", name, path)
 	}
+	for _, tile := range sheet.Tiles {
+		s.addTileFromSprite(tile.Name, img, tile.Col*ts, tile.Row*ts, ts)
+	}
+}
 
-	return err
+// Adds a tile from a spritesheet the store
+// sx, sy are the position of the top left corner of the sub image on the spritesheet
+func (s *Store) addTileFromSprite(name string, source *ebiten.Image, sx, sy, size int) {
+	fmt.Printf("Name: %s, sx %d, sy %d\n", name, sx, sy)
+	subImg := util.GetSubImage(source, image.Rect(sx, sy, sx+size, sy+size))
+	s.addImageToStore(name, subImg)
 }
 
 // A function to load audio files
-func loadAudioFile(path string, sampleRate int) ([]byte, error) {
-	f, err := os.Open(path)
+func loadAudioFile(fsys fs.FS, name string, sampleRate int) ([]byte, error) {
+	f, err := fsys.Open(name)
 	if err != nil {
 		return nil, fmt.Errorf("Could not opn: %w", err)
 	}
 	defer f.Close()
 
-	ext := strings.ToLower(filepath.Ext(path))
-	switch ext {
+	stream, err := decodeAudio(f, path.Ext(name), sampleRate)
+	if err != nil {
+		return nil, err
+	}
+	// Read the whole decoded stream and save it to memory
+	data, err := io.ReadAll(stream)
+	if err != nil {
+		return nil, fmt.Errorf("Error while reading the decoded audio stream: %w", err)
+	}
+	return data, nil
+}
+
+// A decoded audio stream that keeps its file open while it is read
+type audioStream struct {
+	io.Reader
+	file fs.File
+}
+
+func (a *audioStream) Close() error {
+	return a.file.Close()
+}
+
+// Opens an audio file and decodes it while it gets read
+func openAudioStream(fsys fs.FS, name string, sampleRate int) (io.ReadCloser, error) {
+	f, err := fsys.Open(name)
+	if err != nil {
+		return nil, fmt.Errorf("Could not opn: %w", err)
+	}
+	stream, err := decodeAudio(f, path.Ext(name), sampleRate)
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+	return &audioStream{Reader: stream, file: f}, nil
+}
+
+// Decodes the audio to 16 bit stereo with the given sample rate
+func decodeAudio(r io.Reader, ext string, sampleRate int) (io.Reader, error) {
+	switch strings.ToLower(ext) {
 	case ".wav":
-		s, err := wav.DecodeWithSampleRate(sampleRate, f)
+		s, err := wav.DecodeWithSampleRate(sampleRate, r)
 		if err != nil {
 			return nil, fmt.Errorf("wav decoding gone wrong: %w", err)
 		}
-		// Read the whole decoded stream and save it to memory
-		data, err := io.ReadAll(s)
-		if err != nil {
-			return nil, fmt.Errorf("Error while reading the decoded audio stream: %w", err)
-		}
-		return data, nil
+		return s, nil
 	case ".mp3":
-		s, err := mp3.DecodeWithSampleRate(sampleRate, f)
+		s, err := mp3.DecodeWithSampleRate(sampleRate, r)
 		if err != nil {
 			return nil, fmt.Errorf("mp3 decoding gone wrong: %w", err)
 		}
-		// Read the whole decoded stream and save it to memory
-		data, err := io.ReadAll(s)
-		if err != nil {
-			return nil, fmt.Errorf("Error while reading the decoded audio stream: %w", err)
-		}
-		return data, nil
+		return s, nil
 	case ".ogg":
-		s, err := vorbis.DecodeWithSampleRate(sampleRate, f)
+		s, err := vorbis.DecodeWithSampleRate(sampleRate, r)
 		if err != nil {
 			return nil, fmt.Errorf("ogg/vorbis decoding gone wrong: %w", err)
 		}
-		// Read the whole decoded stream and save it to memory
-		data, err := io.ReadAll(s)
-		if err != nil {
-			return nil, fmt.Errorf("Error while reading the decoded audio stream: %w", err)
-		}
-		return data, nil
+		return s, nil
 	default:
 		return nil, fmt.Errorf("Unknown Audio Format: %s", ext)
 	}
 }
 
-func loadFontFile(path string, size float64, dpi float64) (font.Face, error) {
-	fontBytes, err := os.ReadFile(path)
+// LoadImage loads an image from the asset files without adding it to the store
+func LoadImage(path string) (*ebiten.Image, error) {
+	return loadImageFile(FS, path)
+}
+
+func loadImageFile(fsys fs.FS, path string) (*ebiten.Image, error) {
+	f, err := fsys.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	img, _, err := image.Decode(f)
+	if err != nil {
+		return nil, err
+	}
+	return ebiten.NewImageFromImage(img), nil
+}
+
+func loadFontFile(fsys fs.FS, path string, size float64, dpi float64) (font.Face, error) {
+	fontBytes, err := fs.ReadFile(fsys, path)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,120 @@
+package assets
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+)
+
+// The name of the manifest in the root of the asset files
+const manifestName = "manifest.json"
+
+type AssetType string
+
+const (
+	ImageAsset AssetType = "image"
+	SFXAsset   AssetType = "sfx"
+	MusicAsset AssetType = "music"
+	FontAsset  AssetType = "font"
+	// A data file with animation definitions. Animations are loaded
+	// after all images because they are cut from the loaded images.
+	AnimationAsset AssetType = "animations"
+)
+
+// The manifest lists every asset of the game
+type Manifest struct {
+	Assets []ManifestEntry `json:"assets"`
+}
+
+// A single asset. Path is relative to the root of the asset files.
+type ManifestEntry struct {
+	Name    string       `json:"name"`
+	Type    AssetType    `json:"type"`
+	Path    string       `json:"path"`
+	Size    int          `json:"size,omitempty"`    // The size of fonts
+	Preload bool         `json:"preload,omitempty"` // Preloaded assets are available on the loading screen
+	Sheet   *SpriteSheet `json:"sheet,omitempty"`   // Images can be cut into tiles
+}
+
+// A SpriteSheet cuts an image into tiles that are added to the store as their own images.
+// Either all tiles of a grid are added as <prefix><row>_<col> or only the named tiles.
+type SpriteSheet struct {
+	TileSize int         `json:"tile_size,omitempty"` // Uses config.TILE_SIZE if not set
+	Prefix   string      `json:"prefix,omitempty"`
+	Cols     int         `json:"cols,omitempty"`
+	Rows     int         `json:"rows,omitempty"`
+	Tiles    []SheetTile `json:"tiles,omitempty"`
+}
+
+type SheetTile struct {
+	Name string `json:"name"`
+	Col  int    `json:"col"`
+	Row  int    `json:"row"`
+}
+
+// LoadManifest reads the manifest from the root of the file system
+func LoadManifest(fsys fs.FS) (*Manifest, error) {
+	data, err := fs.ReadFile(fsys, manifestName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the asset manifest: %w", err)
+	}
+	return ParseManifest(data)
+}
+
+// ParseManifest parses and validates a manifest. All problems
+// of the manifest are returned together.
+func ParseManifest(data []byte) (*Manifest, error) {
+	var manifest Manifest
+	if err := json.Unmarshal(data, &manifest); err != nil {
+		return nil, fmt.Errorf("failed to parse the asset manifest: %w", err)
+	}
+	if err := manifest.validate(); err != nil {
+		return nil, err
+	}
+	return &manifest, nil
+}
+
+func (m *Manifest) validate() error {
+	var errs []error
+	names := make(map[AssetType]map[string]bool)
+	for i, entry := range m.Assets {
+		if entry.Name == "" {
+			errs = append(errs, fmt.Errorf("asset %d has no name", i))
+		}
+		if entry.Path == "" {
+			errs = append(errs, fmt.Errorf("asset '%s' has no path", entry.Name))
+		}
+		switch entry.Type {
+		case ImageAsset, SFXAsset, MusicAsset, AnimationAsset:
+		case FontAsset:
+			if entry.Size <= 0 {
+				errs = append(errs, fmt.Errorf("font '%s' has no size", entry.Name))
+			}
+		default:
+			errs = append(errs, fmt.Errorf("asset '%s' has the unknown type '%s'", entry.Name, entry.Type))
+		}
+		if entry.Sheet != nil && entry.Type != ImageAsset {
+			errs = append(errs, fmt.Errorf("asset '%s' has a sprite sheet but is no image", entry.Name))
+		}
+		if names[entry.Type] == nil {
+			names[entry.Type] = make(map[string]bool)
+		}
+		if names[entry.Type][entry.Name] {
+			errs = append(errs, fmt.Errorf("the %s '%s' is listed more than once", entry.Type, entry.Name))
+		}
+		names[entry.Type][entry.Name] = true
+	}
+	return errors.Join(errs...)
+}
+
+// Filter returns the entries the keep function returns true for
+func (m *Manifest) Filter(keep func(entry ManifestEntry) bool) []ManifestEntry {
+	var entries []ManifestEntry
+	for _, entry := range m.Assets {
+		if keep(entry) {
+			entries = append(entries, entry)
+		}
+	}
+	return entries
+}
@@ -0,0 +1,70 @@
+package atlas
+
+import (
+	"image"
+	"slices"
+
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+// Atlas packs many small images into a few large pages. Ebitengine can
+// only batch draws that use the same texture, so drawing images from
+// the same page keeps the amount of draw calls low.
+type Atlas struct {
+	pages  []*ebiten.Image
+	images map[string]*ebiten.Image
+}
+
+// New copies the images onto pages of pageSize x pageSize pixels.
+// Images that are larger than a page are kept as they are.
+// Sub-images are copied as well, only their own area ends up on the page.
+func New(images map[string]*ebiten.Image, pageSize, padding int) *Atlas {
+	// Sorted names so the same images always end up at the same place
+	names := make([]string, 0, len(images))
+	for name := range images {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	sizes := make([]image.Point, len(names))
+	for i, name := range names {
+		sizes[i] = images[name].Bounds().Size()
+	}
+	placements, pageCount := Pack(sizes, pageSize, padding)
+
+	a := &Atlas{
+		pages:  make([]*ebiten.Image, pageCount),
+		images: make(map[string]*ebiten.Image, len(names)),
+	}
+	for i := range a.pages {
+		a.pages[i] = ebiten.NewImage(pageSize, pageSize)
+	}
+	for i, name := range names {
+		src := images[name]
+		placement := placements[i]
+		if placement.Page < 0 {
+			a.images[name] = src
+			continue
+		}
+		dst := a.pages[placement.Page]
+		bounds := src.Bounds()
+		op := &ebiten.DrawImageOptions{}
+		op.GeoM.Translate(float64(placement.Rect.Min.X-bounds.Min.X), float64(placement.Rect.Min.Y-bounds.Min.Y))
+		op.Blend = ebiten.BlendCopy
+		dst.DrawImage(src, op)
+		a.images[name] = dst.SubImage(placement.Rect).(*ebiten.Image)
+	}
+	return a
+}
+
+// Image returns the image with the given name. It is a sub-image of a
+// page unless the image was too large for the atlas.
+func (a *Atlas) Image(name string) (*ebiten.Image, bool) {
+	img, ok := a.images[name]
+	return img, ok
+}
+
+// Pages returns the pages of the atlas
+func (a *Atlas) Pages() []*ebiten.Image {
+	return a.pages
+}
@@ -0,0 +1,90 @@
+package atlas_test
+
+import (
+	"image"
+	"testing"
+
+	"github.com/N3moAhead/harvest/internal/atlas"
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+func TestPackKeepsRectanglesApart(t *testing.T) {
+	sizes := []image.Point{{32, 32}, {16, 16}, {64, 16}, {16, 48}, {8, 8}, {32, 32}, {50, 10}}
+	const pageSize, padding = 64, 1
+	placements, pages := atlas.Pack(sizes, pageSize, padding)
+	if len(placements) != len(sizes) {
+		t.Fatalf("Expected %d placements, got %d", len(sizes), len(placements))
+	}
+
+	page := image.Rect(0, 0, pageSize, pageSize)
+	for i, p := range placements {
+		if sizes[i].X+padding > pageSize {
+			if p.Page != -1 {
+				t.Errorf("Expected the too wide rectangle %d to be skipped, got page %d", i, p.Page)
+			}
+			continue
+		}
+		if p.Page < 0 || p.Page >= pages {
+			t.Fatalf("Rectangle %d is on the invalid page %d of %d", i, p.Page, pages)
+		}
+		if p.Rect.Size() != sizes[i] {
+			t.Errorf("Rectangle %d has the size %v, expected %v", i, p.Rect.Size(), sizes[i])
+		}
+		if !p.Rect.In(page) {
+			t.Errorf("Rectangle %d %v is outside of the page", i, p.Rect)
+		}
+		// Growing one of two rectangles by the padding must not make them overlap
+		grown := image.Rect(p.Rect.Min.X, p.Rect.Min.Y, p.Rect.Max.X+padding, p.Rect.Max.Y+padding)
+		for j, other := range placements[:i] {
+			if other.Page == p.Page && grown.Overlaps(other.Rect) {
+				t.Errorf("Rectangle %d %v is too close to %d %v", i, p.Rect, j, other.Rect)
+			}
+		}
+	}
+	if pages < 2 {
+		t.Errorf("Expected the rectangles to need more than one page, got %d", pages)
+	}
+}
+
+func TestPackSkipsEmptyAndHugeSizes(t *testing.T) {
+	placements, pages := atlas.Pack([]image.Point{{0, 10}, {100, 100}}, 64, 0)
+	if pages != 0 {
+		t.Errorf("Expected no pages, got %d", pages)
+	}
+	for i, p := range placements {
+		if p.Page != -1 {
+			t.Errorf("Expected rectangle %d to be skipped, got page %d", i, p.Page)
+		}
+	}
+}
+
+func TestNewReturnsSubImagesOfThePages(t *testing.T) {
+	sheet := ebiten.NewImage(64, 32)
+	images := map[string]*ebiten.Image{
+		"icon":  ebiten.NewImage(16, 16),
+		"sheet": sheet,
+		// A tile cut out of a sheet like the tiles of the manifest
+		"tile": sheet.SubImage(image.Rect(32, 16, 48, 32)).(*ebiten.Image),
+		"huge": ebiten.NewImage(300, 20),
+	}
+	a := atlas.New(images, 128, 1)
+
+	if len(a.Pages()) != 1 {
+		t.Fatalf("Expected one page, got %d", len(a.Pages()))
+	}
+	for name, src := range images {
+		img, ok := a.Image(name)
+		if !ok {
+			t.Fatalf("Expected the image '%s'", name)
+		}
+		if img.Bounds().Size() != src.Bounds().Size() {
+			t.Errorf("The image '%s' has the size %v, expected %v", name, img.Bounds().Size(), src.Bounds().Size())
+		}
+	}
+	if huge, _ := a.Image("huge"); huge != images["huge"] {
+		t.Error("Expected the image that is larger than a page to stay separate")
+	}
+	if _, ok := a.Image("missing"); ok {
+		t.Error("Expected no image for an unknown name")
+	}
+}
@@ -0,0 +1,92 @@
+package atlas
+
+import (
+	"cmp"
+	"image"
+	"slices"
+)
+
+// Placement is the position of a packed rectangle.
+// Rectangles that are too large for a page get the page -1.
+type Placement struct {
+	Page int
+	Rect image.Rectangle
+}
+
+// A row of rectangles on a page. Every rectangle of the
+// shelf starts at the same y and is at most as high as the shelf.
+type shelf struct {
+	y      int
+	height int
+	x      int // Where the next rectangle of the shelf starts
+}
+
+type page struct {
+	shelves []shelf
+	nextY   int // Where the next shelf starts
+}
+
+// Pack places rectangles of the given sizes on square pages.
+// The rectangles are kept apart by the padding so filtering does not
+// bleed the pixels of one image into another. Tall rectangles are
+// placed first, which keeps the shelves tight.
+// Returns the placement of every size in the same order and the amount of pages.
+func Pack(sizes []image.Point, pageSize, padding int) ([]Placement, int) {
+	order := make([]int, len(sizes))
+	for i := range order {
+		order[i] = i
+	}
+	slices.SortStableFunc(order, func(a, b int) int {
+		if c := cmp.Compare(sizes[b].Y, sizes[a].Y); c != 0 {
+			return c
+		}
+		return cmp.Compare(sizes[b].X, sizes[a].X)
+	})
+
+	placements := make([]Placement, len(sizes))
+	var pages []*page
+	for _, i := range order {
+		size := sizes[i]
+		width := size.X + padding
+		height := size.Y + padding
+		if size.X <= 0 || size.Y <= 0 || width > pageSize || height > pageSize {
+			placements[i] = Placement{Page: -1}
+			continue
+		}
+
+		placed := false
+		for pageIndex, p := range pages {
+			if x, y, ok := p.place(width, height, pageSize); ok {
+				placements[i] = Placement{Page: pageIndex, Rect: image.Rect(x, y, x+size.X, y+size.Y)}
+				placed = true
+				break
+			}
+		}
+		if !placed {
+			p := &page{}
+			pages = append(pages, p)
+			x, y, _ := p.place(width, height, pageSize)
+			placements[i] = Placement{Page: len(pages) - 1, Rect: image.Rect(x, y, x+size.X, y+size.Y)}
+		}
+	}
+	return placements, len(pages)
+}
+
+// Finds space for the rectangle on an existing shelf or opens a new one
+func (p *page) place(width, height, pageSize int) (x, y int, ok bool) {
+	for i := range p.shelves {
+		s := &p.shelves[i]
+		if height <= s.height && s.x+width <= pageSize {
+			x, y = s.x, s.y
+			s.x += width
+			return x, y, true
+		}
+	}
+	if p.nextY+height > pageSize {
+		return 0, 0, false
+	}
+	p.shelves = append(p.shelves, shelf{y: p.nextY, height: height, x: width})
+	y = p.nextY
+	p.nextY += height
+	return 0, y, true
+}
@@ -0,0 +1,136 @@
+package audio_test
+
+import (
+	"encoding/binary"
+	"math"
+	"testing"
+
+	"github.com/N3moAhead/harvest/internal/audio"
+)
+
+type fakeVoice struct {
+	playing bool
+	closed  bool
+}
+
+func (v *fakeVoice) IsPlaying() bool {
+	return v.playing
+}
+
+func (v *fakeVoice) Close() error {
+	v.playing = false
+	v.closed = true
+	return nil
+}
+
+func TestBusVolumeIsMultipliedWithItsParents(t *testing.T) {
+	master := audio.NewBus(nil)
+	sfx := audio.NewBus(master)
+	master.SetVolume(0.5)
+	sfx.SetVolume(0.4)
+	if got := sfx.OutputVolume(); math.Abs(got-0.2) > 1e-9 {
+		t.Errorf("Expected an output volume of 0.2, got %v", got)
+	}
+	sfx.SetVolume(3)
+	if sfx.Volume() != 1 {
+		t.Errorf("Expected the volume to be clamped to 1, got %v", sfx.Volume())
+	}
+}
+
+func TestVoicePoolStealsTheOldestVoice(t *testing.T) {
+	pool := audio.NewVoicePool(2)
+	first := &fakeVoice{playing: true}
+	second := &fakeVoice{playing: true}
+	third := &fakeVoice{playing: true}
+	pool.Add(first)
+	pool.Add(second)
+	pool.Add(third)
+
+	if !first.closed {
+		t.Error("Expected the oldest voice to be stolen")
+	}
+	if second.closed || third.closed {
+		t.Error("Expected the newer voices to keep playing")
+	}
+	if pool.Len() != 2 {
+		t.Errorf("Expected 2 voices in the pool, got %d", pool.Len())
+	}
+}
+
+func TestVoicePoolReusesFinishedVoices(t *testing.T) {
+	pool := audio.NewVoicePool(2)
+	finished := &fakeVoice{playing: true}
+	playing := &fakeVoice{playing: true}
+	pool.Add(finished)
+	pool.Add(playing)
+
+	finished.playing = false
+	pool.Add(&fakeVoice{playing: true})
+	if playing.closed {
+		t.Error("Expected the finished voice to make room instead of stealing a playing one")
+	}
+	if !finished.closed {
+		t.Error("Expected the finished voice to be closed")
+	}
+}
+
+func TestVoicePoolWithoutLimit(t *testing.T) {
+	pool := audio.NewVoicePool(0)
+	for range 100 {
+		pool.Add(&fakeVoice{playing: true})
+	}
+	if pool.Len() != 100 {
+		t.Errorf("Expected 100 voices, got %d", pool.Len())
+	}
+}
+
+// Creates stereo pcm data where both channels hold the same samples
+func newPCM(samples ...int16) []byte {
+	pcm := make([]byte, len(samples)*4)
+	for i, sample := range samples {
+		binary.LittleEndian.PutUint16(pcm[i*4:], uint16(sample))
+		binary.LittleEndian.PutUint16(pcm[i*4+2:], uint16(sample))
+	}
+	return pcm
+}
+
+func samples(pcm []byte) []int16 {
+	result := make([]int16, len(pcm)/4)
+	for i := range result {
+		result[i] = int16(binary.LittleEndian.Uint16(pcm[i*4:]))
+	}
+	return result
+}
+
+func TestResampleWithoutPitchChangeKeepsTheData(t *testing.T) {
+	pcm := newPCM(1, 2, 3)
+	if got := audio.Resample(pcm, 1); &got[0] != &pcm[0] {
+		t.Error("Expected the same data for a pitch of 1")
+	}
+}
+
+func TestResampleHigherPitchIsShorter(t *testing.T) {
+	got := samples(audio.Resample(newPCM(0, 100, 200, 300, 400, 500), 2))
+	want := []int16{0, 200, 400}
+	if len(got) != len(want) {
+		t.Fatalf("Expected %v, got %v", want, got)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("Expected %v, got %v", want, got)
+		}
+	}
+}
+
+func TestResampleLowerPitchInterpolates(t *testing.T) {
+	got := samples(audio.Resample(newPCM(0, 100, -100), 0.5))
+	want := []int16{0, 50, 100, 0, -100, -100}
+	if len(got) != len(want) {
+		t.Fatalf("Expected %v, got %v", want, got)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("Expected %v, got %v", want, got)
+		}
+	}
+}
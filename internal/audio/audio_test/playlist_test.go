@@ -0,0 +1,98 @@
+package audio_test
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/N3moAhead/harvest/internal/audio"
+)
+
+type fakeTrack struct {
+	*bytes.Reader
+	closed *int
+}
+
+func (t fakeTrack) Close() error {
+	*t.closed++
+	return nil
+}
+
+// Opens tracks whose audio is the name of the track
+func fakeOpener(closed *int) audio.OpenFunc {
+	return func(name string) (io.ReadCloser, error) {
+		if name == "missing" {
+			return nil, errors.New("not found")
+		}
+		return fakeTrack{Reader: bytes.NewReader([]byte(name)), closed: closed}, nil
+	}
+}
+
+func TestPlaylistPlaysTracksWithoutGaps(t *testing.T) {
+	closed := 0
+	playlist := audio.NewPlaylist(fakeOpener(&closed), "ab", "cd")
+	buf := make([]byte, 10)
+	if _, err := io.ReadFull(playlist, buf); err != nil {
+		t.Fatal(err)
+	}
+	if got := string(buf); got != "abcdabcdab" {
+		t.Errorf("Expected the tracks to loop without gaps, got %q", got)
+	}
+	if closed != 4 {
+		t.Errorf("Expected the 4 finished tracks to be closed, got %d", closed)
+	}
+
+	playlist.Close()
+	if closed != 5 {
+		t.Errorf("Expected the current track to be closed, got %d", closed)
+	}
+	if _, err := playlist.Read(buf); err == nil {
+		t.Error("Expected an error after closing the playlist")
+	}
+}
+
+func TestPlaylistSkipsTracksThatCanNotBeOpened(t *testing.T) {
+	closed := 0
+	playlist := audio.NewPlaylist(fakeOpener(&closed), "missing", "xy")
+	buf := make([]byte, 4)
+	if _, err := io.ReadFull(playlist, buf); err != nil {
+		t.Fatal(err)
+	}
+	if got := string(buf); got != "xyxy" {
+		t.Errorf("Expected only the working track, got %q", got)
+	}
+}
+
+func TestPlaylistOnlyTriesBrokenTracksOnce(t *testing.T) {
+	closed := 0
+	opened := map[string]int{}
+	open := func(name string) (io.ReadCloser, error) {
+		opened[name]++
+		return fakeOpener(&closed)(name)
+	}
+	playlist := audio.NewPlaylist(open, "ab", "missing", "cd")
+	buf := make([]byte, 12)
+	if _, err := io.ReadFull(playlist, buf); err != nil {
+		t.Fatal(err)
+	}
+	if got := string(buf); got != "abcdabcdabcd" {
+		t.Errorf("Expected the working tracks to loop, got %q", got)
+	}
+	if opened["missing"] != 1 {
+		t.Errorf("Expected the broken track to be tried once, got %d", opened["missing"])
+	}
+}
+
+func TestPlaylistWithoutPlayableTracksEnds(t *testing.T) {
+	closed := 0
+	playlist := audio.NewPlaylist(fakeOpener(&closed), "missing")
+	for range 2 {
+		if _, err := playlist.Read(make([]byte, 4)); !errors.Is(err, io.EOF) {
+			t.Errorf("Expected io.EOF, got %v", err)
+		}
+	}
+	if track := playlist.CurrentTrack(); track != "" {
+		t.Errorf("Expected the broken track to be removed, got %s", track)
+	}
+}
@@ -0,0 +1,33 @@
+package audio
+
+// A Bus groups sounds that share a volume. Buses can be nested so the
+// volume of a bus is multiplied with the volumes of all its parents.
+type Bus struct {
+	volume float64
+	parent *Bus
+}
+
+// NewBus creates a bus with full volume. parent can be nil for the master bus.
+func NewBus(parent *Bus) *Bus {
+	return &Bus{
+		volume: 1,
+		parent: parent,
+	}
+}
+
+// SetVolume sets the volume of the bus itself between 0 and 1
+func (b *Bus) SetVolume(volume float64) {
+	b.volume = max(0, min(volume, 1))
+}
+
+func (b *Bus) Volume() float64 {
+	return b.volume
+}
+
+// OutputVolume returns the volume sounds on this bus are played with
+func (b *Bus) OutputVolume() float64 {
+	if b.parent == nil {
+		return b.volume
+	}
+	return b.volume * b.parent.OutputVolume()
+}
@@ -0,0 +1,52 @@
+package audio
+
+import (
+	"github.com/N3moAhead/harvest/internal/config"
+	ebitenaudio "github.com/hajimehoshi/ebiten/v2/audio"
+)
+
+// The Manager plays all sounds and music of the game. The volume
+// of everything can be changed with the buses. Master changes the
+// volume of music and sound effects together.
+type Manager struct {
+	Master    *Bus
+	Music     *Bus
+	SFX       *Bus
+	context   *ebitenaudio.Context
+	sfxVoices *VoicePool // Limits the sound effects playing at the same time over all sounds
+	music     *musicTrack
+	fadingOut []*musicTrack
+}
+
+func NewManager(context *ebitenaudio.Context) *Manager {
+	master := NewBus(nil)
+	return &Manager{
+		Master:    master,
+		Music:     NewBus(master),
+		SFX:       NewBus(master),
+		context:   context,
+		sfxVoices: NewVoicePool(config.AUDIO_MAX_SFX_VOICES),
+	}
+}
+
+// NewSound creates a sound effect from decoded audio
+func (m *Manager) NewSound(data []byte, options SoundOptions) *Sound {
+	if options.Volume == 0 {
+		options.Volume = 1
+	}
+	if options.MaxVoices == 0 {
+		options.MaxVoices = config.AUDIO_MAX_VOICES_PER_SOUND
+	}
+	return &Sound{
+		manager:  m,
+		data:     data,
+		options:  options,
+		voices:   NewVoicePool(options.MaxVoices),
+		variants: make(map[int][]byte),
+	}
+}
+
+// Update has to be called every tick to fade the music
+func (m *Manager) Update(dt float64) {
+	m.updateMusic(dt)
+}
@@ -0,0 +1,73 @@
+package audio
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/N3moAhead/harvest/internal/config"
+	ebitenaudio "github.com/hajimehoshi/ebiten/v2/audio"
+)
+
+// Music that fades in or out
+type musicTrack struct {
+	name   string
+	player *ebitenaudio.Player
+	stream io.Closer
+	fade   float64 // The volume of the fade between 0 and 1
+}
+
+// IsMusicPlaying returns true if the music with the name is the current music
+func (m *Manager) IsMusicPlaying(name string) bool {
+	return m.music != nil && m.music.name == name
+}
+
+// PlayMusic streams the music and crossfades from the music that was playing
+// before. The stream is closed after the music faded out. Usually the stream
+// is a Playlist so the music never ends.
+func (m *Manager) PlayMusic(name string, stream io.ReadCloser) {
+	player, err := m.context.NewPlayer(stream)
+	if err != nil {
+		fmt.Println("Warning: Could not play music", name, err)
+		stream.Close()
+		return
+	}
+	m.StopMusic()
+	m.music = &musicTrack{name: name, player: player, stream: stream}
+	m.applyMusicVolume(m.music)
+	player.Play()
+}
+
+// StopMusic fades out the current music
+func (m *Manager) StopMusic() {
+	if m.music != nil {
+		m.fadingOut = append(m.fadingOut, m.music)
+		m.music = nil
+	}
+}
+
+// Fades the current music in and the previous music out
+func (m *Manager) updateMusic(dt float64) {
+	step := dt / config.AUDIO_CROSSFADE_SECONDS
+	if m.music != nil {
+		m.music.fade = min(1, m.music.fade+step)
+		m.applyMusicVolume(m.music)
+	}
+	n := 0
+	for _, track := range m.fadingOut {
+		track.fade -= step
+		if track.fade <= 0 {
+			track.player.Close()
+			track.stream.Close()
+			continue
+		}
+		m.applyMusicVolume(track)
+		m.fadingOut[n] = track
+		n++
+	}
+	clear(m.fadingOut[n:])
+	m.fadingOut = m.fadingOut[:n]
+}
+
+func (m *Manager) applyMusicVolume(track *musicTrack) {
+	track.player.SetVolume(track.fade * m.Music.OutputVolume())
+}
@@ -0,0 +1,48 @@
+package audio
+
+import (
+	"encoding/binary"
+	"math"
+)
+
+// Decoded audio is 16 bit little endian stereo.
+// A frame holds one sample of both channels.
+const (
+	bytesPerSample = 2
+	channels       = 2
+	bytesPerFrame  = bytesPerSample * channels
+)
+
+// Resample changes the pitch of decoded audio by playing it faster or slower.
+// A pitch of 2 plays the sound an octave higher and half as long.
+// Samples in between two frames are interpolated linearly.
+func Resample(pcm []byte, pitch float64) []byte {
+	if pitch <= 0 || pitch == 1 {
+		return pcm
+	}
+	frames := len(pcm) / bytesPerFrame
+	if frames == 0 {
+		return pcm
+	}
+	outFrames := int(math.Ceil(float64(frames) / pitch))
+	out := make([]byte, outFrames*bytesPerFrame)
+	for i := range outFrames {
+		src := float64(i) * pitch
+		frame := int(src)
+		frac := src - float64(frame)
+		next := min(frame+1, frames-1)
+		for ch := range channels {
+			a := sampleAt(pcm, frame, ch)
+			b := sampleAt(pcm, next, ch)
+			sample := math.Round(a + (b-a)*frac)
+			offset := i*bytesPerFrame + ch*bytesPerSample
+			binary.LittleEndian.PutUint16(out[offset:], uint16(int16(sample)))
+		}
+	}
+	return out
+}
+
+func sampleAt(pcm []byte, frame, channel int) float64 {
+	offset := frame*bytesPerFrame + channel*bytesPerSample
+	return float64(int16(binary.LittleEndian.Uint16(pcm[offset:])))
+}
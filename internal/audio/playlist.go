@@ -0,0 +1,115 @@
+package audio
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"sync"
+)
+
+// OpenFunc opens the decoded audio of the track with the given name
+type OpenFunc func(name string) (io.ReadCloser, error)
+
+// A Playlist plays its tracks one after another and starts again
+// with the first track after the last one. The tracks are read as one
+// continuous stream so there is no gap between them. A playlist with
+// a single track loops it without a gap.
+type Playlist struct {
+	tracks  []string
+	open    OpenFunc
+	index   int // The index of the current track
+	current io.ReadCloser
+	closed  bool
+	mu      sync.Mutex // The player reads the playlist from another goroutine
+}
+
+func NewPlaylist(open OpenFunc, tracks ...string) *Playlist {
+	return &Playlist{
+		tracks: slices.Clone(tracks), // Tracks that can not be opened get removed
+		open:   open,
+	}
+}
+
+// Read reads the decoded audio of the current track. When the
+// track ends the next track is opened in the same read.
+func (p *Playlist) Read(buf []byte) (int, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.closed {
+		return 0, io.ErrClosedPipe
+	}
+	// Every track gets one chance per read. If none of the tracks
+	// returns any audio the playlist ends instead of spinning forever.
+	for attempts := 0; attempts <= len(p.tracks); attempts++ {
+		if p.current == nil {
+			if len(p.tracks) == 0 {
+				break
+			}
+			if err := p.openCurrent(); err != nil {
+				// The track is removed so the warning is not shown on every loop
+				fmt.Println("Warning:", err)
+				p.removeCurrent()
+				continue
+			}
+		}
+		n, err := p.current.Read(buf)
+		if errors.Is(err, io.EOF) {
+			p.current.Close()
+			p.current = nil
+			p.next()
+			if n == 0 {
+				continue
+			}
+			err = nil
+		}
+		return n, err
+	}
+	return 0, io.EOF
+}
+
+func (p *Playlist) openCurrent() error {
+	current, err := p.open(p.tracks[p.index])
+	if err != nil {
+		return fmt.Errorf("could not open track %s: %w", p.tracks[p.index], err)
+	}
+	p.current = current
+	return nil
+}
+
+// Removes a track that can not be played. The next track becomes the current one.
+func (p *Playlist) removeCurrent() {
+	p.tracks = slices.Delete(p.tracks, p.index, p.index+1)
+	if p.index >= len(p.tracks) {
+		p.index = 0
+	}
+}
+
+func (p *Playlist) next() {
+	if len(p.tracks) > 0 {
+		p.index = (p.index + 1) % len(p.tracks)
+	}
+}
+
+// CurrentTrack returns the name of the track that is currently read
+func (p *Playlist) CurrentTrack() string {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if len(p.tracks) == 0 {
+		return ""
+	}
+	return p.tracks[p.index]
+}
+
+// Close closes the current track. The playlist can not be read afterwards.
+func (p *Playlist) Close() error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.closed = true
+	if p.current == nil {
+		return nil
+	}
+	err := p.current.Close()
+	p.current = nil
+	return err
+}
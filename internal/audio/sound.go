@@ -0,0 +1,59 @@
+package audio
+
+import (
+	"math"
+	"math/rand/v2"
+
+	"github.com/N3moAhead/harvest/internal/config"
+)
+
+// SoundOptions decide how a sound effect is played
+type SoundOptions struct {
+	Volume       float64 // The volume of the sound before the bus volume is applied. 0 means full volume.
+	VolumeJitter float64 // The volume is changed randomly by up to this share
+	PitchJitter  float64 // The pitch is changed randomly by up to this share
+	MaxVoices    int     // How often the sound can play at the same time. 0 uses the default.
+}
+
+// A Sound is a sound effect that can be played many times.
+// Every time it is played it sounds a bit different so repeated
+// sounds like impacts do not get annoying.
+type Sound struct {
+	manager  *Manager
+	data     []byte
+	options  SoundOptions
+	voices   *VoicePool
+	variants map[int][]byte // The resampled data for each pitch step
+}
+
+// Play plays the sound on the sfx bus
+func (s *Sound) Play() {
+	pitch := 1 + randomSpread(s.options.PitchJitter)
+	player := s.manager.context.NewPlayerFromBytes(s.variant(pitch))
+	volume := s.options.Volume * (1 + randomSpread(s.options.VolumeJitter))
+	player.SetVolume(max(0, min(volume*s.manager.SFX.OutputVolume(), 1)))
+
+	s.voices.Add(player)
+	s.manager.sfxVoices.Add(player)
+	player.Play()
+}
+
+// The pitch is rounded to steps so only a few resampled versions
+// of the sound have to be kept in memory
+func (s *Sound) variant(pitch float64) []byte {
+	step := int(math.Round((pitch - 1) / config.AUDIO_PITCH_STEP))
+	if step == 0 {
+		return s.data
+	}
+	data, ok := s.variants[step]
+	if !ok {
+		data = Resample(s.data, 1+float64(step)*config.AUDIO_PITCH_STEP)
+		s.variants[step] = data
+	}
+	return data
+}
+
+// Returns a random value between -spread and spread
+func randomSpread(spread float64) float64 {
+	return (rand.Float64()*2 - 1) * spread
+}
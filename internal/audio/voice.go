@@ -0,0 +1,54 @@
+package audio
+
+// A Voice is a sound that is currently playing
+type Voice interface {
+	IsPlaying() bool
+	Close() error
+}
+
+// A VoicePool limits how many voices can play at the same time.
+// If the pool is full the oldest voice gets stopped to make room
+// for the new one. This is called voice stealing.
+type VoicePool struct {
+	maxVoices int
+	voices    []Voice // The oldest voice is first
+}
+
+// NewVoicePool creates a pool for up to maxVoices voices. 0 means no limit.
+func NewVoicePool(maxVoices int) *VoicePool {
+	return &VoicePool{
+		maxVoices: maxVoices,
+	}
+}
+
+// Add puts a voice into the pool and steals the oldest voices if the pool is full.
+// The voice should be added before it starts playing.
+func (p *VoicePool) Add(voice Voice) {
+	p.prune()
+	for p.maxVoices > 0 && len(p.voices) >= p.maxVoices {
+		p.voices[0].Close()
+		p.voices = p.voices[1:]
+	}
+	p.voices = append(p.voices, voice)
+}
+
+// Len returns the amount of voices that are still playing
+func (p *VoicePool) Len() int {
+	p.prune()
+	return len(p.voices)
+}
+
+// Removes the voices that finished playing
+func (p *VoicePool) prune() {
+	n := 0
+	for _, voice := range p.voices {
+		if !voice.IsPlaying() {
+			voice.Close()
+			continue
+		}
+		p.voices[n] = voice
+		n++
+	}
+	clear(p.voices[n:])
+	p.voices = p.voices[:n]
+}
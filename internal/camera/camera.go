@@ -0,0 +1,179 @@
+package camera
+
+import (
+	"math"
+	"math/rand/v2"
+
+	"github.com/N3moAhead/harvest/internal/component"
+	"github.com/N3moAhead/harvest/internal/config"
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+// The camera decides which part of the world is visible on the screen.
+// It smoothly follows a target, looks a bit ahead into the direction
+// the target is facing, can zoom and shakes after heavy hits.
+type Camera struct {
+	pos          component.Vector2D // The world position in the center of the screen
+	target       component.Vector2D // The position the camera is moving to
+	speed        float64            // How fast the camera is moving. The camera is faster as higher this value gets
+	zoom         float64
+	targetZoom   float64
+	trauma       float64            // The strength of the shake between 0 and 1. It fades out over time.
+	shakeOffset  component.Vector2D // The current offset caused by the shake
+	screenWidth  float64
+	screenHeight float64
+	bounds       component.Rect // The camera does not show anything outside of the bounds
+	bounded      bool
+}
+
+func NewCamera(screenWidth, screenHeight int) *Camera {
+	return &Camera{
+		speed:        config.CAMERA_SPEED,
+		zoom:         1,
+		targetZoom:   1,
+		screenWidth:  float64(screenWidth),
+		screenHeight: float64(screenHeight),
+	}
+}
+
+// SetScreenSize changes the size of the area the world is drawn on
+func (c *Camera) SetScreenSize(screenWidth, screenHeight int) {
+	c.screenWidth = float64(screenWidth)
+	c.screenHeight = float64(screenHeight)
+}
+
+// SetBounds keeps the visible area inside of the bounds.
+// Used for maps that have a fixed size.
+func (c *Camera) SetBounds(bounds component.Rect) {
+	c.bounds = bounds
+	c.bounded = true
+}
+
+// Follow moves the camera to the target. The camera looks a bit
+// ahead into the facing direction so the player sees more of what
+// is coming. The facing direction should be normalized.
+func (c *Camera) Follow(target, facing component.Vector2D) {
+	c.target = target.Add(facing.Mul(config.CAMERA_LOOK_AHEAD))
+}
+
+// Snap moves the camera to its target right away without the smooth movement
+func (c *Camera) Snap() {
+	c.pos = c.target
+	c.zoom = c.targetZoom
+	c.clamp()
+}
+
+// SetZoom smoothly zooms to the given zoom level.
+// Values above 1 zoom in, values below 1 zoom out.
+func (c *Camera) SetZoom(zoom float64) {
+	c.targetZoom = math.Max(config.CAMERA_MIN_ZOOM, math.Min(zoom, config.CAMERA_MAX_ZOOM))
+}
+
+// Zoom returns the zoom level the camera is moving to
+func (c *Camera) Zoom() float64 {
+	return c.targetZoom
+}
+
+// Scale returns the current zoom level. Sizes in the world have
+// to be multiplied with it to get their size on the screen.
+func (c *Camera) Scale() float64 {
+	return c.zoom
+}
+
+// Shake adds trauma to the camera. The screen shakes stronger the more
+// trauma the camera has. The trauma is capped at 1 and fades out over time.
+func (c *Camera) Shake(trauma float64) {
+	c.trauma = math.Min(1, c.trauma+trauma)
+}
+
+func (c *Camera) Update(dt float64) {
+	// Smoothly move the camera towards the target position (Linear Interpolation - Lerp)
+	// Using dt makes it frame-independent.
+	diff := c.target.Sub(c.pos)
+	moveStep := diff.Mul(c.speed * dt)
+	// Avoid "overshooting" at high frame rate/speed - move at most the difference
+	if moveStep.Len() > diff.Len() || moveStep.Len() < 0.1 {
+		c.pos = c.target
+	} else {
+		c.pos = c.pos.Add(moveStep)
+	}
+
+	c.zoom += (c.targetZoom - c.zoom) * math.Min(1, config.CAMERA_ZOOM_SPEED*dt)
+	if math.Abs(c.targetZoom-c.zoom) < 0.001 {
+		c.zoom = c.targetZoom
+	}
+
+	// The shake gets weaker quadratically so small hits barely move the camera
+	c.trauma = math.Max(0, c.trauma-config.CAMERA_SHAKE_DECAY*dt)
+	strength := c.trauma * c.trauma * config.CAMERA_SHAKE_MAX_OFFSET
+	c.shakeOffset = component.NewVector2D(
+		(rand.Float64()*2-1)*strength,
+		(rand.Float64()*2-1)*strength,
+	)
+
+	c.clamp()
+}
+
+// Keeps the visible area inside of the bounds. If the bounds are
+// smaller than the visible area the bounds get centered.
+func (c *Camera) clamp() {
+	if !c.bounded {
+		return
+	}
+	halfWidth := c.screenWidth / c.zoom / 2
+	halfHeight := c.screenHeight / c.zoom / 2
+	c.pos.X = clampAxis(c.pos.X, c.bounds.X+halfWidth, c.bounds.X+c.bounds.Width-halfWidth)
+	c.pos.Y = clampAxis(c.pos.Y, c.bounds.Y+halfHeight, c.bounds.Y+c.bounds.Height-halfHeight)
+}
+
+func clampAxis(v, min, max float64) float64 {
+	if min > max {
+		return (min + max) / 2
+	}
+	return math.Max(min, math.Min(v, max))
+}
+
+// Position returns the world position in the center of the screen
+func (c *Camera) Position() component.Vector2D {
+	return c.pos
+}
+
+// The center of the screen including the shake
+func (c *Camera) center() component.Vector2D {
+	return c.pos.Add(c.shakeOffset)
+}
+
+// WorldToScreen converts a position in the world to a position on the screen
+func (c *Camera) WorldToScreen(pos component.Vector2D) component.Vector2D {
+	return component.NewVector2D(
+		(pos.X-c.center().X)*c.zoom+c.screenWidth/2,
+		(pos.Y-c.center().Y)*c.zoom+c.screenHeight/2,
+	)
+}
+
+// ScreenToWorld converts a position on the screen, like the cursor, to a position in the world
+func (c *Camera) ScreenToWorld(pos component.Vector2D) component.Vector2D {
+	return component.NewVector2D(
+		(pos.X-c.screenWidth/2)/c.zoom+c.center().X,
+		(pos.Y-c.screenHeight/2)/c.zoom+c.center().Y,
+	)
+}
+
+// GeoM returns the world to screen transformation. Images positioned
+// in world coordinates are moved onto the screen by concatenating it:
+//
+//	op.GeoM.Translate(worldX, worldY)
+//	op.GeoM.Concat(cam.GeoM())
+func (c *Camera) GeoM() ebiten.GeoM {
+	var geoM ebiten.GeoM
+	center := c.center()
+	geoM.Translate(-center.X, -center.Y)
+	geoM.Scale(c.zoom, c.zoom)
+	geoM.Translate(c.screenWidth/2, c.screenHeight/2)
+	return geoM
+}
+
+// ViewRect returns the area of the world that is visible on the screen
+func (c *Camera) ViewRect() component.Rect {
+	return component.NewRectAround(c.center(), c.screenWidth/c.zoom, c.screenHeight/c.zoom)
+}
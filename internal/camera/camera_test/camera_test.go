@@ -0,0 +1,92 @@
+package camera_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/N3moAhead/harvest/internal/camera"
+	"github.com/N3moAhead/harvest/internal/component"
+	"github.com/N3moAhead/harvest/internal/config"
+)
+
+const epsilon = 1e-9
+
+func newCameraAt(pos component.Vector2D) *camera.Camera {
+	cam := camera.NewCamera(800, 600)
+	cam.Follow(pos, component.Vector2D{})
+	cam.Snap()
+	return cam
+}
+
+func TestWorldToScreenCentersTheCamera(t *testing.T) {
+	cam := newCameraAt(component.NewVector2D(100, 50))
+	screenPos := cam.WorldToScreen(component.NewVector2D(100, 50))
+	if math.Abs(screenPos.X-400) > epsilon || math.Abs(screenPos.Y-300) > epsilon {
+		t.Errorf("Expected the camera position in the center of the screen, got %v", screenPos)
+	}
+}
+
+func TestScreenToWorldIsTheInverse(t *testing.T) {
+	cam := newCameraAt(component.NewVector2D(-320, 75))
+	cam.SetZoom(1.5)
+	cam.Snap()
+
+	worldPos := component.NewVector2D(-250, 130)
+	screenPos := cam.WorldToScreen(worldPos)
+	back := cam.ScreenToWorld(screenPos)
+	if math.Abs(back.X-worldPos.X) > epsilon || math.Abs(back.Y-worldPos.Y) > epsilon {
+		t.Errorf("Expected %v after converting back, got %v", worldPos, back)
+	}
+
+	// The GeoM has to do the same as WorldToScreen
+	geoM := cam.GeoM()
+	x, y := geoM.Apply(worldPos.X, worldPos.Y)
+	if math.Abs(x-screenPos.X) > epsilon || math.Abs(y-screenPos.Y) > epsilon {
+		t.Errorf("Expected GeoM to move %v to %v, got (%f, %f)", worldPos, screenPos, x, y)
+	}
+}
+
+func TestZoomChangesTheVisibleArea(t *testing.T) {
+	cam := newCameraAt(component.Vector2D{})
+	cam.SetZoom(2)
+	cam.Snap()
+	view := cam.ViewRect()
+	if math.Abs(view.Width-400) > epsilon || math.Abs(view.Height-300) > epsilon {
+		t.Errorf("Expected a view of 400x300 at zoom 2, got %fx%f", view.Width, view.Height)
+	}
+
+	cam.SetZoom(100)
+	if cam.Zoom() != config.CAMERA_MAX_ZOOM {
+		t.Errorf("Expected the zoom to be capped at %f, got %f", config.CAMERA_MAX_ZOOM, cam.Zoom())
+	}
+}
+
+func TestLookAhead(t *testing.T) {
+	cam := camera.NewCamera(800, 600)
+	cam.Follow(component.Vector2D{}, component.NewVector2D(1, 0))
+	cam.Snap()
+	if got := cam.Position().X; math.Abs(got-config.CAMERA_LOOK_AHEAD) > epsilon {
+		t.Errorf("Expected the camera to look %f pixels ahead, got %f", config.CAMERA_LOOK_AHEAD, got)
+	}
+}
+
+func TestBoundsKeepTheViewInside(t *testing.T) {
+	cam := camera.NewCamera(800, 600)
+	cam.SetBounds(component.Rect{Width: 2000, Height: 2000})
+	cam.Follow(component.NewVector2D(10, 1990), component.Vector2D{})
+	cam.Snap()
+	view := cam.ViewRect()
+	if view.X < 0 || view.Y+view.Height > 2000+epsilon {
+		t.Errorf("Expected the view to stay inside of the bounds, got %+v", view)
+	}
+}
+
+func TestShakeFadesOut(t *testing.T) {
+	cam := newCameraAt(component.Vector2D{})
+	cam.Shake(1)
+	// After enough time the shake is gone and the camera is back in place
+	cam.Update(1/config.CAMERA_SHAKE_DECAY + 0.1)
+	if view := cam.ViewRect(); math.Abs(view.Center().X) > epsilon || math.Abs(view.Center().Y) > epsilon {
+		t.Errorf("Expected the shake to be over, got a view centered at %v", view.Center())
+	}
+}
@@ -0,0 +1,137 @@
+package chest_test
+
+import (
+	"math/rand"
+	"slices"
+	"testing"
+
+	"github.com/N3moAhead/harvest/internal/chest"
+	"github.com/N3moAhead/harvest/internal/config"
+	"github.com/N3moAhead/harvest/internal/entity/item/itemtype"
+	"github.com/N3moAhead/harvest/internal/entity/player/inventory"
+	"github.com/N3moAhead/harvest/internal/weapon"
+)
+
+// The amount of chests that are opened per test
+const chestCount = 500
+
+// Levels the weapon up until it reached its max level
+func maxOut(w weapon.Weapon) weapon.Weapon {
+	for w.LevelUp() {
+	}
+	return w
+}
+
+func TestRewardsOnlyLevelUpUpgradeableWeapons(t *testing.T) {
+	inv := inventory.NewInventory()
+	inv.AddWeapon(maxOut(weapon.NewSpoon()))
+	knife := weapon.NewThrowingKnife()
+	inv.AddWeapon(knife)
+	levelsLeft := knife.MaxLevel() - knife.Level()
+
+	rng := rand.New(rand.NewSource(1))
+	knifeRewards := 0
+	for range chestCount {
+		levelUps := 0
+		for _, reward := range chest.RollRewards(rng, inv) {
+			if reward.Kind != chest.RewardWeaponLevel {
+				continue
+			}
+			if reward.ItemType != itemtype.ThrowingKnifes {
+				t.Fatalf("Expected only the throwing knife to level up, got %v", reward.ItemType)
+			}
+			levelUps++
+		}
+		if levelUps > levelsLeft {
+			t.Fatalf("Expected at most %d level ups in a chest, got %d", levelsLeft, levelUps)
+		}
+		knifeRewards += levelUps
+	}
+	if knifeRewards == 0 {
+		t.Error("Expected the throwing knife to be leveled up by some chests")
+	}
+}
+
+func TestFullyUpgradedInventoryFallsBackToSoupsAndGold(t *testing.T) {
+	full := inventory.NewInventory()
+	full.AddWeapon(maxOut(weapon.NewSpoon()))
+	full.AddWeapon(maxOut(weapon.NewRollingPin()))
+	full.AddWeapon(maxOut(weapon.NewThermalmixer()))
+	full.AddWeapon(maxOut(weapon.NewThrowingKnife()))
+
+	for name, inv := range map[string]*inventory.Inventory{
+		"fully upgraded": full,
+		"empty":          inventory.NewInventory(),
+	} {
+		rng := rand.New(rand.NewSource(1))
+		kinds := make(map[chest.RewardKind]int)
+		for range chestCount {
+			for _, reward := range chest.RollRewards(rng, inv) {
+				kinds[reward.Kind]++
+				switch reward.Kind {
+				case chest.RewardSoup:
+					if reward.ItemType.Category() != itemtype.CategorySoup {
+						t.Fatalf("%s: Expected a soup, got %v", name, reward.ItemType)
+					}
+				case chest.RewardGold:
+					if reward.ItemType != itemtype.Undefined {
+						t.Fatalf("%s: Expected gold to have no item type, got %v", name, reward.ItemType)
+					}
+				default:
+					t.Fatalf("%s: Expected only soups and gold, got %v", name, reward.Kind)
+				}
+			}
+		}
+		if kinds[chest.RewardSoup] == 0 || kinds[chest.RewardGold] == 0 {
+			t.Errorf("%s: Expected soups and gold, got %v", name, kinds)
+		}
+	}
+}
+
+func TestRewardCounts(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	counts := make(map[int]int)
+	for range chestCount {
+		counts[len(chest.RollRewards(rng, inventory.NewInventory()))]++
+	}
+	for count := range counts {
+		if !slices.Contains([]int{1, 3, 5}, count) {
+			t.Errorf("Expected chests to hold 1, 3 or 5 rewards, got %d", count)
+		}
+	}
+	if counts[1] <= counts[3] || counts[3] <= counts[5] {
+		t.Errorf("Expected larger chests to be rarer, got %v", counts)
+	}
+}
+
+func TestSameSeedRollsTheSameRewards(t *testing.T) {
+	inv := inventory.NewInventory()
+	inv.AddWeapon(weapon.NewSpoon())
+	a := rand.New(rand.NewSource(7))
+	b := rand.New(rand.NewSource(7))
+	for range chestCount {
+		rewardsA := chest.RollRewards(a, inv)
+		rewardsB := chest.RollRewards(b, inv)
+		if !slices.Equal(rewardsA, rewardsB) {
+			t.Fatalf("Expected the same rewards, got %v and %v", rewardsA, rewardsB)
+		}
+	}
+}
+
+func TestRevealFinishes(t *testing.T) {
+	for _, rewardCount := range []int{0, 1, 3, 5} {
+		rewards := make([]chest.Reward, rewardCount)
+		reveal := chest.NewReveal(rewards)
+		ticks := 1
+		for !reveal.Update() {
+			ticks++
+			if ticks > 1000 {
+				t.Fatalf("Expected the reveal of %d rewards to finish", rewardCount)
+			}
+		}
+		// The rewards have to be visible for a moment before the reveal ends
+		if ticks < config.CHEST_REVEAL_DURATION+config.CHEST_REVEAL_HOLD {
+			t.Errorf("Expected the reveal of %d rewards to take at least %d ticks, got %d", rewardCount, config.CHEST_REVEAL_DURATION+config.CHEST_REVEAL_HOLD, ticks)
+		}
+	}
+}
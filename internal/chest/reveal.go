@@ -0,0 +1,106 @@
+package chest
+
+import (
+	"fmt"
+	"image/color"
+	"math"
+
+	"github.com/N3moAhead/harvest/internal/assets"
+	"github.com/N3moAhead/harvest/internal/config"
+	"github.com/N3moAhead/harvest/internal/drawstats"
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/text"
+	"github.com/hajimehoshi/ebiten/v2/vector"
+)
+
+const (
+	chestIconScale  = 4.0  // How much larger the chest is drawn during the reveal
+	rewardIconScale = 2.0  // How much larger the reward icons are drawn
+	rewardGap       = 80.0 // The horizontal space between two rewards
+	shakeTicks      = 30   // The chest shakes for this many ticks before it opens
+)
+
+// Reveal is the short animation that plays after the player picked
+// up a chest. The chest shakes for a moment and afterwards the rewards
+// pop up one after the other.
+type Reveal struct {
+	Rewards   []Reward
+	tick      int
+	chestIcon *ebiten.Image
+}
+
+func NewReveal(rewards []Reward) *Reveal {
+	chestIcon, ok := assets.AssetStore.GetImage("chest_icon")
+	if !ok {
+		fmt.Println("Warning: Could not load chest_icon in NewReveal")
+	}
+	return &Reveal{
+		Rewards:   rewards,
+		chestIcon: chestIcon,
+	}
+}
+
+// Update advances the reveal animation and
+// returns true as soon as the reveal is over
+func (r *Reveal) Update() (finished bool) {
+	r.tick++
+	return r.tick >= shakeTicks+config.CHEST_REVEAL_DURATION+config.CHEST_REVEAL_HOLD
+}
+
+// The amount of rewards that are currently visible
+func (r *Reveal) revealedRewards() int {
+	if r.tick < shakeTicks || len(r.Rewards) == 0 {
+		return 0
+	}
+	ticksPerReward := config.CHEST_REVEAL_DURATION / len(r.Rewards)
+	revealed := (r.tick-shakeTicks)/ticksPerReward + 1
+	return min(revealed, len(r.Rewards))
+}
+
+func (r *Reveal) Draw(screen *ebiten.Image) {
+	screenWidth, screenHeight := float64(screen.Bounds().Dx()), float64(screen.Bounds().Dy())
+	vector.DrawFilledRect(screen, 0, 0, float32(screenWidth), float32(screenHeight), color.RGBA{R: 0, G: 0, B: 0, A: 160}, false)
+
+	centerX := screenWidth / 2
+	centerY := screenHeight / 2
+
+	// --- Chest ---
+	if r.chestIcon != nil {
+		shakeOffset := 0.0
+		if r.tick < shakeTicks {
+			shakeOffset = math.Sin(float64(r.tick)*1.5) * 3
+		}
+		iconSize := float64(r.chestIcon.Bounds().Dx()) * chestIconScale
+		op := &ebiten.DrawImageOptions{}
+		op.GeoM.Scale(chestIconScale, chestIconScale)
+		op.GeoM.Translate(centerX-iconSize/2+shakeOffset, centerY-iconSize-40)
+		drawstats.DrawImage(screen, r.chestIcon, op)
+	}
+
+	// --- Rewards ---
+	fontFace, ok := assets.AssetStore.GetFont("micro")
+	if !ok {
+		fmt.Println("Warning: Could not load font micro in chest reveal")
+	}
+	revealed := r.revealedRewards()
+	rowWidth := float64(len(r.Rewards)-1) * rewardGap
+	for i := 0; i < revealed; i++ {
+		reward := r.Rewards[i]
+		rewardX := centerX - rowWidth/2 + float64(i)*rewardGap
+		rewardY := centerY + 20
+
+		icon, ok := assets.AssetStore.GetImage(reward.IconName())
+		if ok {
+			iconSize := float64(icon.Bounds().Dx()) * rewardIconScale
+			op := &ebiten.DrawImageOptions{}
+			op.GeoM.Scale(rewardIconScale, rewardIconScale)
+			op.GeoM.Translate(rewardX-iconSize/2, rewardY)
+			drawstats.DrawImage(screen, icon, op)
+		}
+		if fontFace != nil {
+			label := reward.Label()
+			bounds := text.BoundString(fontFace, label)
+			text.Draw(screen, label, fontFace, int(rewardX)-bounds.Dx()/2, int(rewardY+60), color.White)
+		}
+	}
+}
@@ -0,0 +1,117 @@
+package chest
+
+import (
+	"math/rand"
+
+	"github.com/N3moAhead/harvest/internal/config"
+	"github.com/N3moAhead/harvest/internal/entity/item"
+	"github.com/N3moAhead/harvest/internal/entity/item/itemtype"
+	"github.com/N3moAhead/harvest/internal/entity/player/inventory"
+)
+
+type RewardKind int
+
+const (
+	RewardWeaponLevel RewardKind = iota
+	RewardSoup
+	RewardGold
+)
+
+func (rk RewardKind) String() string {
+	switch rk {
+	case RewardWeaponLevel:
+		return "Weapon Level"
+	case RewardSoup:
+		return "Soup"
+	case RewardGold:
+		return "Gold"
+	default:
+		return "Unknown"
+	}
+}
+
+// A single reward inside of a chest.
+// ItemType is the weapon that gets leveled up or the soup that gets added.
+// For gold rewards it stays undefined.
+type Reward struct {
+	Kind     RewardKind
+	ItemType itemtype.ItemType
+}
+
+// Returns the name of the icon asset that represents the reward
+func (r Reward) IconName() string {
+	if r.Kind == RewardGold {
+		return "gold_icon"
+	}
+	if info, ok := item.ItemInfos[r.ItemType]; ok {
+		return info.IconName
+	}
+	return "no_icon"
+}
+
+// Returns a short text describing the reward
+func (r Reward) Label() string {
+	switch r.Kind {
+	case RewardWeaponLevel:
+		return r.ItemType.String() + " +1"
+	case RewardSoup:
+		return r.ItemType.String()
+	case RewardGold:
+		return "Gold"
+	default:
+		return "???"
+	}
+}
+
+// Rolls how many rewards a chest contains. Most chests hold
+// a single reward, some hold 3 and very few hold 5.
+func rollRewardCount(rng *rand.Rand) int {
+	roll := rng.Float64()
+	switch {
+	case roll < config.CHEST_FIVE_REWARDS_PROB:
+		return 5
+	case roll < config.CHEST_FIVE_REWARDS_PROB+config.CHEST_THREE_REWARDS_PROB:
+		return 3
+	default:
+		return 1
+	}
+}
+
+// RollRewards picks the content of a chest. Weapon level ups are only
+// chosen from the weapons the player already owns and never push a weapon
+// above its max level. If no weapon can be leveled up anymore
+// the chest falls back to soups and gold. The numbers come from
+// the given source so the rewards can be reproduced.
+func RollRewards(rng *rand.Rand, inv *inventory.Inventory) []Reward {
+	count := rollRewardCount(rng)
+	// Keep track of the level ups we already handed out in this chest
+	pendingLevels := make(map[itemtype.ItemType]int)
+	soupTypes := itemtype.GetItemTypesByCategory(itemtype.CategorySoup)
+
+	rewards := make([]Reward, 0, count)
+	for range count {
+		upgradeable := make([]itemtype.ItemType, 0)
+		for _, w := range inv.Weapons {
+			if w == nil {
+				continue
+			}
+			if w.Level()+pendingLevels[w.GetType()] < w.MaxLevel() {
+				upgradeable = append(upgradeable, w.GetType())
+			}
+		}
+
+		roll := rng.Float64()
+		switch {
+		case len(upgradeable) > 0 && roll < 0.5:
+			weaponType := upgradeable[rng.Intn(len(upgradeable))]
+			pendingLevels[weaponType]++
+			rewards = append(rewards, Reward{Kind: RewardWeaponLevel, ItemType: weaponType})
+		case len(soupTypes) > 0 && roll < 0.75:
+			soupType := soupTypes[rng.Intn(len(soupTypes))]
+			rewards = append(rewards, Reward{Kind: RewardSoup, ItemType: soupType})
+		default:
+			rewards = append(rewards, Reward{Kind: RewardGold, ItemType: itemtype.Undefined})
+		}
+	}
+	return rewards
+}
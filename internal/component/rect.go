@@ -0,0 +1,39 @@
+package component
+
+// Rect is an axis aligned rectangle. X and Y are the top left corner.
+type Rect struct {
+	X, Y          float64
+	Width, Height float64
+}
+
+// NewRectAround creates a rect with the given size that is centered on center
+func NewRectAround(center Vector2D, width, height float64) Rect {
+	return Rect{
+		X:      center.X - width/2,
+		Y:      center.Y - height/2,
+		Width:  width,
+		Height: height,
+	}
+}
+
+// Center returns the center point of the rect
+func (r Rect) Center() Vector2D {
+	return Vector2D{r.X + r.Width/2, r.Y + r.Height/2}
+}
+
+// Translate returns a copy of the rect moved by delta
+func (r Rect) Translate(delta Vector2D) Rect {
+	return Rect{r.X + delta.X, r.Y + delta.Y, r.Width, r.Height}
+}
+
+// Intersects returns true if both rects overlap.
+// Rects that only touch each other at their edges do not intersect.
+func (r Rect) Intersects(other Rect) bool {
+	return r.X < other.X+other.Width && other.X < r.X+r.Width &&
+		r.Y < other.Y+other.Height && other.Y < r.Y+r.Height
+}
+
+// Contains returns true if the point lies inside of the rect
+func (r Rect) Contains(p Vector2D) bool {
+	return p.X >= r.X && p.X < r.X+r.Width && p.Y >= r.Y && p.Y < r.Y+r.Height
+}
@@ -8,12 +8,30 @@ const (
 	/// --- Window Settings ---
 	SCREEN_WIDTH  = 896
 	SCREEN_HEIGHT = 504
+	/// --- Scene Settings ---
+	SCENE_TRANSITION_TIME = 0.6 // Seconds a transition takes. The first half covers the old scene, the second half shows the new one.
+	/// --- Rendering Settings ---
+	ATLAS_PAGE_SIZE = 2048 // The width and height in pixels of a texture atlas page
+	ATLAS_PADDING   = 1    // The space in pixels between two images on an atlas page
 	/// --- Camera Settings ---
-	CAMERA_SPEED = 6.0
+	CAMERA_SPEED            = 6.0
+	CAMERA_LOOK_AHEAD       = 48.0 // How many pixels the camera looks ahead into the facing direction of the player
+	CAMERA_MIN_ZOOM         = 0.75
+	CAMERA_MAX_ZOOM         = 2.0
+	CAMERA_ZOOM_STEP        = 0.25 // The zoom change of one step of the mouse wheel
+	CAMERA_ZOOM_SPEED       = 8.0  // How fast the camera reaches a new zoom level
+	CAMERA_SHAKE_MAX_OFFSET = 12.0 // The offset in pixels of a shake with full trauma
+	CAMERA_SHAKE_DECAY      = 1.5  // The trauma that fades out per second
+	CAMERA_SHAKE_ON_HIT     = 0.3  // The trauma added when the player gets hit
+	CAMERA_SHAKE_ON_SLAM    = 0.7  // The trauma added when an elite slams the player
 	/// --- World Settings ---
-	TILE_SIZE       = 16  // TILE_SIZE is the size of a tile in pixels.
-	HEIGHT_IN_TILES = 200 // The number of tiles in the X direction.
-	WIDTH_IN_TILES  = 200 // The number of tiles in the Y direction.
+	TILE_SIZE           = 16               // TILE_SIZE is the size of a tile in pixels.
+	CHUNK_SIZE          = 32               // The width and height of a chunk in tiles
+	CHUNK_LOAD_RADIUS   = 2                // Chunks this many chunks away from the player get loaded
+	CHUNK_EVICT_RADIUS  = 4                // Chunks further away than this many chunks get removed
+	FLOW_FIELD_RADIUS   = 48               // The radius in tiles around the player enemies find a path in
+	SPAWN_AREA_IN_TILES = 200              // Weapons get scattered in an area of this size around the spawn
+	ARENA_MAP_PATH      = "maps/arena.tmj" // The handcrafted map that can be chosen in the menu
 	/// --- Player Settings ---
 	INITIAL_PLAYER_SPEED           = 3.0 // Initial Player Speed
 	INITIAL_PLAYER_MAGNET_RADIUS   = 50.0
@@ -23,14 +41,43 @@ const (
 	SHOW_RECIPE_RANGE              = 200.0 // The range in which the player can see the recipe of a cookstation
 	PLAYER_MAX_HEALTH              = 100
 	PLAYER_LEVEL_FACTOR            = 0.2
+	PLAYER_HITBOX_SIZE             = 14.0 // The size in pixels of the box that collides with obstacles
+	/// --- Input Settings ---
+	GAMEPAD_STICK_DEADZONE = 0.2    // Analog sticks that are tilted less than this are ignored
+	REBIND_TIMEOUT         = 5 * 60 // Ticks until rebinding an action gets cancelled
+	/// --- Settings File ---
+	SETTINGS_DIR_NAME  = "harvest"       // The directory in the config directory of the user
+	SETTINGS_FILE_NAME = "settings.json" // The file the settings scene saves to
+	/// --- Save Game ---
+	SAVE_FILE_NAME = "run.json" // The run saved by Save & Quit. It is stored next to the settings.
+	/// --- Leaderboard ---
+	LEADERBOARD_FILE_NAME = "leaderboard.json" // Stored next to the settings
+	LEADERBOARD_SIZE      = 10                 // The amount of best runs that are kept
+	RUN_HISTORY_SIZE      = 50                 // The amount of last runs that are kept
+	/// --- Asset Settings ---
+	ASSET_OVERRIDE_DIR = "mods" // Files in this directory replace the embedded assets with the same path
 	/// --- Audio Settings ---
-	AUDIO_SAMPLE_RATE = 44100
+	AUDIO_SAMPLE_RATE          = 44100
+	AUDIO_MAX_SFX_VOICES       = 24   // The amount of sound effects that can play at the same time
+	AUDIO_MAX_VOICES_PER_SOUND = 4    // The amount of times the same sound can play at the same time
+	AUDIO_PITCH_STEP           = 0.02 // Random pitches are rounded to this step
+	AUDIO_CROSSFADE_SECONDS    = 1.5  // The duration of the crossfade between two music tracks
 	/// --- Inventory Settings ---
 	MAX_WEAPONS = 5
 	/// --- HUD Settings ---
 	VEGTABLE_TYPE_AMOUNT = 6  // The amount of diffrent vegtable types
 	SOUP_TYPE_AMOUNT     = 3  // The amount of diffrent soup types
 	ITEM_FRAME_SIZE      = 48 // The size in pixels of an item frame
+	/// --- Minimap Settings ---
+	MINIMAP_SIZE               = 140    // The width and height of the minimap in pixels
+	MINIMAP_MARGIN             = 10     // The distance of the minimap to the corner of the screen
+	MINIMAP_RANGE              = 1600.0 // The distance in world pixels from the player to the border of the minimap
+	MINIMAP_ICON_SIZE          = 10     // The size in pixels of cook station and weapon icons on the minimap
+	MINIMAP_DENSITY_CELLS      = 14     // The amount of cells per row the enemy density is counted in
+	MINIMAP_DENSITY_MAX        = 6.0    // A cell with this many enemies gets the strongest color
+	OFFSCREEN_INDICATOR_RANGE  = 1200.0 // Cook stations closer than this get an arrow at the border of the screen
+	OFFSCREEN_INDICATOR_MARGIN = 16     // The distance of the arrows to the border of the screen
+	OFFSCREEN_INDICATOR_SIZE   = 12.0   // The length of the arrows in pixels
 	/// --- Icon Settings ---
 	ICON_SIZE               = 16.0 // The size in pixels of icon assets
 	ICON_ON_MAP_RENDER_SIZE = 16.0 // The size in pixels on how large an item icon should be rendered
@@ -39,6 +86,8 @@ const (
 	DAMAGE_INDICATOR_SPEED      = 0.5
 	DAMAGE_INDICATOR_DURATION   = 500 * time.Millisecond
 	ENEMY_SEPERATION_RADIUS     = 16.0 // The radius space for each enemy
+	ENEMY_HITBOX_SIZE           = 12.0 // The size in pixels of the box that collides with obstacles
+	ENEMY_HIT_RANGE_FACTOR      = 1.5  // Players that are within the attack range times this factor on the impact frame of an attack get hit
 	ENEMY_PER_SUB_FORMATION     = 10   // The amount of enemies that can spawn in a line or zig zag pattern
 	ENDLESS_MODE_ENEMY_AMOUNT   = 2000 // Enough enemies to fulfil the 3,000 capacity will be spawned in each tick.
 	ENEMY_UPDATE_INTERVAL       = 30   // The amount of seconds until an enemy gets an upgrade
@@ -46,6 +95,13 @@ const (
 	ENEMY_UPDATE_INTERVAL_SPEED = 25   // The amount of speed an enemy gets after each update set by ENEMY_UPDATE_INTERVAL
 	ENEMY_UPDATE_MAX_SCALE      = 2    // The maximum scale an enemy can reach after updates
 	ENEMY_UPDATE_INTERVAL_SCALE = 0.2  // The amount of scale an enemy gets after each update set by ENEMY_UPDATE_INTERVAL
+	// Elite Enemies
+	ELITES_PER_WAVE         = 2     // The amount of enemies of each wave that get promoted to an elite
+	ELITE_ENDLESS_CHANCE    = 0.002 // The chance of an enemy spawned in endless mode to be an elite
+	ELITE_HEALTH_MULTIPLIER = 10.0  // Elites have this many times the health of their normal variant
+	ELITE_SCALE             = 1.5   // The initial scale of an elite enemy
+	ELITE_SCORE             = 500   // The score the player gets for killing an elite
+	ELITE_GLOW_PULSE_SPEED  = 4.0   // How fast the glow around an elite is pulsing
 	// Enemy: Carrot
 	CARROT_SPEED                  = 65.0
 	CARROT_HEALTH                 = 2
@@ -120,6 +176,12 @@ const (
 	POTATO_COLOR_G = 146
 	POTATO_COLOR_B = 104
 	POTATO_COLOR_A = 255
+	/// --- Chest Settings ---
+	CHEST_REVEAL_DURATION    = 90   // Ticks until all rewards of a chest are revealed
+	CHEST_REVEAL_HOLD        = 60   // Ticks the revealed rewards stay on the screen
+	CHEST_THREE_REWARDS_PROB = 0.25 // The chance of a chest containing 3 rewards
+	CHEST_FIVE_REWARDS_PROB  = 0.05 // The chance of a chest containing 5 rewards
+	CHEST_GOLD_SCORE         = 2500 // The amount of score a gold reward is worth
 	///--- TOASTS ---
 	DEFAULT_TOAST_DURATION = 2 * time.Second
 	TOAST_GAP              = 10
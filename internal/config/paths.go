@@ -0,0 +1,16 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+)
+
+// FilePath returns the location of a file in the config directory of the game.
+// If the config directory of the user is unknown the working directory is used.
+func FilePath(fileName string) string {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return fileName
+	}
+	return filepath.Join(dir, SETTINGS_DIR_NAME, fileName)
+}
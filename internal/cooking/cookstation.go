@@ -8,7 +8,9 @@ import (
 
 	"github.com/N3moAhead/harvest/internal/animation"
 	"github.com/N3moAhead/harvest/internal/assets"
+	"github.com/N3moAhead/harvest/internal/camera"
 	"github.com/N3moAhead/harvest/internal/config"
+	"github.com/N3moAhead/harvest/internal/drawstats"
 	"github.com/N3moAhead/harvest/internal/entity"
 	"github.com/N3moAhead/harvest/internal/entity/item/itemtype"
 	"github.com/N3moAhead/harvest/internal/entity/player"
@@ -68,19 +70,10 @@ func GetRandomRecipe() Recipe {
 }
 
 func NewCookStation(x, y float64, recipe Recipe, costFactor float64) *CookStation {
-	animationStore := animation.NewAnimationStore()
-	cookStation, ok := assets.AssetStore.GetImage("cook_station")
-	if ok {
-		defaultAnimation, err := animation.NewAnimation(cookStation, 64, 64, 0, 0, 8, 6, true)
-		// defaultAnimation, err := animation.NewAnimation(cookStation, 32, 32, 0, 32, 8, 6, false)
-		// defaultAnimation, err := animation.NewAnimation(cookStation, 32, 32, 0, 0, 8, 6, false)
-		if err == nil {
-			animationStore.AddAnimation("default", defaultAnimation)
-		}
-		ok := animationStore.SetCurrentAnimation("default")
-		if !ok {
-			fmt.Println("Warning: Unable to start the spawning animation")
-		}
+	animationStore, ok := assets.AssetStore.GetAnimations("cook_station")
+	if !ok {
+		fmt.Println("Warning: Unable to load the cook station animations")
+		animationStore = animation.NewAnimationStore()
 	}
 
 	baseEntity := entity.NewEntity(x, y)
@@ -124,26 +117,28 @@ func (cookStation *CookStation) Update(player *player.Player, inv *inventory.Inv
 	return 0
 }
 
-func (cs *CookStation) Draw(screen *ebiten.Image, camX, camY float64) {
+func (cs *CookStation) Draw(screen *ebiten.Image, cam *camera.Camera) {
 	if cs.Used {
 		return
 	}
 	frameImage := cs.animationStore.GetImage()
 	if frameImage != nil {
 		op := &ebiten.DrawImageOptions{}
-		op.GeoM.Translate(cs.Pos.X-camX-16.0, cs.Pos.Y-camY-16.0)
-		screen.DrawImage(frameImage, op)
+		op.GeoM.Translate(cs.Pos.X-16.0, cs.Pos.Y-16.0)
+		op.GeoM.Concat(cam.GeoM())
+		drawstats.DrawImage(screen, frameImage, op)
 
 	} else {
-		cs.DefaultDraw(screen, camX, camY, config.DEFAULT_ENEMY_ASSET_SIZE, config.DEFAULT_ENEMY_ASSET_SIZE,
+		cs.DefaultDraw(screen, cam, config.DEFAULT_ENEMY_ASSET_SIZE, config.DEFAULT_ENEMY_ASSET_SIZE,
 			color.RGBA{R: 180, G: 13, B: 27, A: 255})
 	}
 
 	// TODO other way to draw text recept
 	// recept sign?
 	if cs.showRecipe {
-		x := float32(cs.Pos.X - camX + 20)
-		y := float32(cs.Pos.Y - camY - 20)
+		screenPos := cam.WorldToScreen(cs.Pos)
+		x := float32(screenPos.X + 20)
+		y := float32(screenPos.Y - 20)
 		textRecipe := cs.Recipe.Soup.String() + ": "
 		ingredientTypes := make([]itemtype.ItemType, 0)
 		for k, _ := range cs.Recipe.Ingredients {
@@ -166,13 +161,12 @@ func (cs *CookStation) Draw(screen *ebiten.Image, camX, camY float64) {
 
 }
 
-func (cs *CookStation) DefaultDraw(screen *ebiten.Image, camX, camY float64, width int, height int, color color.RGBA) {
-	x := float32(cs.Pos.X - camX)
-	y := float32(cs.Pos.Y - camY)
+func (cs *CookStation) DefaultDraw(screen *ebiten.Image, cam *camera.Camera, width int, height int, color color.RGBA) {
+	screenPos := cam.WorldToScreen(cs.Pos)
 	vector.DrawFilledRect(
 		screen,
-		x, y,
-		float32(width), float32(height),
+		float32(screenPos.X), float32(screenPos.Y),
+		float32(float64(width)*cam.Scale()), float32(float64(height)*cam.Scale()),
 		color,
 		false,
 	)
@@ -0,0 +1,131 @@
+package display
+
+import (
+	"image/color"
+	"math"
+
+	"github.com/N3moAhead/harvest/internal/config"
+	"github.com/N3moAhead/harvest/internal/drawstats"
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+// Resolution is the size the game is drawn in before it gets scaled to the window
+type Resolution struct {
+	Name          string // The aspect ratio. It is stored in the settings.
+	Width, Height int
+}
+
+// 16:9 and 21:9 have the same height so the world shows the same amount
+// of tiles vertically and wider screens see more to the sides.
+// 16:10 keeps the width of 16:9 instead and sees more to the top and bottom.
+var Resolutions = []Resolution{
+	{Name: "16:9", Width: config.SCREEN_WIDTH, Height: config.SCREEN_HEIGHT},
+	{Name: "16:10", Width: config.SCREEN_WIDTH, Height: config.SCREEN_WIDTH * 10 / 16},
+	{Name: "21:9", Width: config.SCREEN_HEIGHT * 21 / 9, Height: config.SCREEN_HEIGHT},
+}
+
+func DefaultResolution() Resolution {
+	return Resolutions[0]
+}
+
+// FindResolution returns the resolution with the given name
+func FindResolution(name string) (Resolution, bool) {
+	for _, resolution := range Resolutions {
+		if resolution.Name == name {
+			return resolution, true
+		}
+	}
+	return Resolution{}, false
+}
+
+var (
+	current      = DefaultResolution()
+	pixelPerfect = true
+	// Where the game was drawn in the window during the last layout
+	viewport = Viewport{Scale: 1}
+)
+
+// SetResolution changes the size the game is drawn in
+func SetResolution(resolution Resolution) {
+	current = resolution
+}
+
+func Current() Resolution {
+	return current
+}
+
+// Size returns the width and height the game is drawn in.
+// Everything on the screen is placed inside of this size.
+func Size() (width, height int) {
+	return current.Width, current.Height
+}
+
+// SetPixelPerfect decides whether the game is only scaled by whole numbers.
+// Whole numbers keep every pixel the same size but leave larger borders.
+func SetPixelPerfect(enabled bool) {
+	pixelPerfect = enabled
+}
+
+// Viewport is the area of the window the game is drawn in
+type Viewport struct {
+	Scale            float64
+	OffsetX, OffsetY float64
+}
+
+// Fit scales a game of the given size as large as possible into the output
+// while keeping its aspect ratio. The space left is split evenly between
+// both sides. Pixel perfect viewports only scale by whole numbers unless
+// the output is smaller than the game.
+func Fit(outputWidth, outputHeight, width, height int, pixelPerfect bool) Viewport {
+	if width <= 0 || height <= 0 {
+		return Viewport{Scale: 1}
+	}
+	scale := math.Min(float64(outputWidth)/float64(width), float64(outputHeight)/float64(height))
+	if pixelPerfect && scale >= 1 {
+		scale = math.Floor(scale)
+	}
+	return Viewport{
+		Scale:   scale,
+		OffsetX: math.Floor((float64(outputWidth) - float64(width)*scale) / 2),
+		OffsetY: math.Floor((float64(outputHeight) - float64(height)*scale) / 2),
+	}
+}
+
+// ToGame converts a position in the window to a position in the game
+func (v Viewport) ToGame(x, y int) (int, int) {
+	return int(math.Floor((float64(x) - v.OffsetX) / v.Scale)), int(math.Floor((float64(y) - v.OffsetY) / v.Scale))
+}
+
+// Layout is used as the layout of the game. The window size is given in
+// device independent pixels, the returned size is in real pixels of the
+// monitor so the game stays sharp on HiDPI screens.
+func Layout(outsideWidth, outsideHeight int) (screenWidth, screenHeight int) {
+	deviceScale := 1.0
+	if monitor := ebiten.Monitor(); monitor != nil {
+		deviceScale = monitor.DeviceScaleFactor()
+	}
+	screenWidth = int(math.Ceil(float64(outsideWidth) * deviceScale))
+	screenHeight = int(math.Ceil(float64(outsideHeight) * deviceScale))
+	viewport = Fit(screenWidth, screenHeight, current.Width, current.Height, pixelPerfect)
+	return screenWidth, screenHeight
+}
+
+// CursorPosition returns the position of the cursor in the game
+func CursorPosition() (x, y int) {
+	return viewport.ToGame(ebiten.CursorPosition())
+}
+
+var letterboxColor = color.RGBA{R: 0, G: 0, B: 0, A: 255}
+
+// Present draws the canvas the game was drawn on into the viewport of the screen
+func Present(screen, canvas *ebiten.Image) {
+	screen.Fill(letterboxColor)
+	op := &ebiten.DrawImageOptions{}
+	op.GeoM.Scale(viewport.Scale, viewport.Scale)
+	op.GeoM.Translate(viewport.OffsetX, viewport.OffsetY)
+	// Whole number scales keep the pixels sharp, other scales are smoothed
+	if viewport.Scale != math.Trunc(viewport.Scale) {
+		op.Filter = ebiten.FilterLinear
+	}
+	drawstats.DrawImage(screen, canvas, op)
+}
@@ -0,0 +1,80 @@
+package display_test
+
+import (
+	"testing"
+
+	"github.com/N3moAhead/harvest/internal/display"
+)
+
+func TestFitScalesByWholeNumbers(t *testing.T) {
+	// 1920x1080 fits 2.14 times, pixel perfect only uses 2
+	viewport := display.Fit(1920, 1080, 896, 504, true)
+	if viewport.Scale != 2 {
+		t.Fatalf("Expected a scale of 2, got %v", viewport.Scale)
+	}
+	if viewport.OffsetX != 64 || viewport.OffsetY != 36 {
+		t.Errorf("Expected the game to be centered at (64, 36), got (%v, %v)", viewport.OffsetX, viewport.OffsetY)
+	}
+}
+
+func TestFitWithoutPixelPerfect(t *testing.T) {
+	viewport := display.Fit(1920, 1080, 896, 504, false)
+	if want := 1920.0 / 896; viewport.Scale != want {
+		t.Errorf("Expected a scale of %v, got %v", want, viewport.Scale)
+	}
+	if viewport.OffsetX != 0 || viewport.OffsetY != 0 {
+		t.Errorf("Expected no border for the same aspect ratio, got (%v, %v)", viewport.OffsetX, viewport.OffsetY)
+	}
+}
+
+func TestFitLetterboxesOtherAspectRatios(t *testing.T) {
+	// An ultrawide game on a 16:9 window gets borders on the top and bottom
+	viewport := display.Fit(1792, 1008, 1176, 504, false)
+	if viewport.OffsetX != 0 || viewport.OffsetY <= 0 {
+		t.Errorf("Expected borders only on the top and bottom, got (%v, %v)", viewport.OffsetX, viewport.OffsetY)
+	}
+}
+
+func TestFitShrinksIntoSmallWindows(t *testing.T) {
+	// Pixel perfect can not scale down by a whole number
+	viewport := display.Fit(448, 252, 896, 504, true)
+	if viewport.Scale != 0.5 {
+		t.Errorf("Expected a scale of 0.5, got %v", viewport.Scale)
+	}
+}
+
+func TestViewportToGame(t *testing.T) {
+	viewport := display.Fit(1920, 1080, 896, 504, true)
+	testCases := []struct {
+		windowX, windowY int
+		wantX, wantY     int
+	}{
+		{64, 36, 0, 0},
+		{65, 37, 0, 0},
+		{66, 38, 1, 1},
+		{1855, 1043, 895, 503},
+		// Positions on the border are outside of the game
+		{10, 10, -27, -13},
+	}
+	for _, tc := range testCases {
+		x, y := viewport.ToGame(tc.windowX, tc.windowY)
+		if x != tc.wantX || y != tc.wantY {
+			t.Errorf("(%d, %d): expected (%d, %d), got (%d, %d)", tc.windowX, tc.windowY, tc.wantX, tc.wantY, x, y)
+		}
+	}
+}
+
+func TestResolutions(t *testing.T) {
+	if _, ok := display.FindResolution("4:3"); ok {
+		t.Error("Expected an unknown resolution not to be found")
+	}
+	for _, resolution := range display.Resolutions {
+		found, ok := display.FindResolution(resolution.Name)
+		if !ok || found != resolution {
+			t.Errorf("Expected to find %s", resolution.Name)
+		}
+	}
+	if display.DefaultResolution().Width != 896 || display.DefaultResolution().Height != 504 {
+		t.Errorf("Expected the default resolution to be 896x504, got %v", display.DefaultResolution())
+	}
+}
@@ -0,0 +1,30 @@
+package drawstats
+
+import "github.com/hajimehoshi/ebiten/v2"
+
+// Counts the images drawn per frame for the debug overlay. Only draws made
+// through DrawImage are counted. Text, vector shapes, triangles and the images
+// of pkg/ui are not, and Ebitengine batches the draws, so the count is not
+// the amount of draw calls the GPU gets.
+// Drawing only happens on the main thread so the counters are not locked.
+var (
+	current   int
+	lastFrame int
+)
+
+// DrawImage draws src onto dst like dst.DrawImage and counts the draw
+func DrawImage(dst, src *ebiten.Image, op *ebiten.DrawImageOptions) {
+	dst.DrawImage(src, op)
+	current++
+}
+
+// EndFrame finishes the count of the current frame
+func EndFrame() {
+	lastFrame = current
+	current = 0
+}
+
+// LastFrame returns the amount of images drawn during the last finished frame
+func LastFrame() int {
+	return lastFrame
+}
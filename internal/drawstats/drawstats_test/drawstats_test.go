@@ -0,0 +1,29 @@
+package drawstats_test
+
+import (
+	"testing"
+
+	"github.com/N3moAhead/harvest/internal/drawstats"
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+func TestDrawsAreCountedPerFrame(t *testing.T) {
+	dst := ebiten.NewImage(16, 16)
+	src := ebiten.NewImage(4, 4)
+	drawstats.EndFrame()
+
+	for range 3 {
+		drawstats.DrawImage(dst, src, &ebiten.DrawImageOptions{})
+	}
+	if drawstats.LastFrame() != 0 {
+		t.Errorf("Expected the running frame to not count yet, got %d", drawstats.LastFrame())
+	}
+	drawstats.EndFrame()
+	if drawstats.LastFrame() != 3 {
+		t.Errorf("Expected 3 draws, got %d", drawstats.LastFrame())
+	}
+	drawstats.EndFrame()
+	if drawstats.LastFrame() != 0 {
+		t.Errorf("Expected an empty frame, got %d", drawstats.LastFrame())
+	}
+}
@@ -1,5 +1,13 @@
 package enemy
 
+import (
+	"errors"
+	"fmt"
+
+	"github.com/N3moAhead/harvest/internal/animation"
+	"github.com/N3moAhead/harvest/internal/assets"
+)
+
 const (
 	WALK_RIGHT   string = "walkRight"
 	WALK_LEFT    string = "walkLeft"
@@ -8,3 +16,37 @@ const (
 	SPAWN        string = "spawn"
 	DEATH        string = "death"
 )
+
+// The event on the impact frame of the attack animations
+const HIT string = "hit"
+
+// The enemies whose animations are loaded from the animation
+// definitions. The name of the animation set is the name of the type.
+var meleeEnemyTypes = []EnemyType{TypeCarrot, TypePotato, TypeCabbage, TypeOnion, TypeLeek, TypeRadish}
+
+// Returns a copy of the animations of the enemy type
+func newMeleeAnimations(enemyType EnemyType) *animation.AnimationStore {
+	store, ok := assets.AssetStore.GetAnimations(enemyType.String())
+	if !ok {
+		panic("Missing animations of the enemy " + enemyType.String())
+	}
+	return store
+}
+
+// CheckAnimations makes sure every melee enemy has all the animations it needs.
+// It is called once after loading the assets so broken animation definitions
+// are noticed before the first enemy spawns.
+func CheckAnimations() error {
+	var errs []error
+	for _, enemyType := range meleeEnemyTypes {
+		store, ok := assets.AssetStore.GetAnimations(enemyType.String())
+		if !ok {
+			errs = append(errs, fmt.Errorf("the animations of the enemy %s are missing", enemyType))
+			continue
+		}
+		if err := checkMeleeAnimations(store); err != nil {
+			errs = append(errs, fmt.Errorf("enemy %s: %w", enemyType, err))
+		}
+	}
+	return errors.Join(errs...)
+}
@@ -1,14 +1,18 @@
 package enemy
 
 import (
+	"errors"
 	"fmt"
 	"image/color"
-	"math/rand/v2"
+	"math"
+	"math/rand"
 	"time"
 
 	"github.com/N3moAhead/harvest/internal/animation"
+	"github.com/N3moAhead/harvest/internal/camera"
 	"github.com/N3moAhead/harvest/internal/component"
 	"github.com/N3moAhead/harvest/internal/config"
+	"github.com/N3moAhead/harvest/internal/drawstats"
 	"github.com/N3moAhead/harvest/internal/entity"
 	"github.com/N3moAhead/harvest/internal/entity/item"
 	"github.com/N3moAhead/harvest/internal/entity/player"
@@ -22,6 +26,7 @@ type BaseMeleeEnemy struct {
 	damageIndicators []*DamageIndicator
 	updateAt         time.Time
 	scale            float64
+	target           *player.Player // The player that gets hit on the impact frame of the attack
 }
 
 type BaseMeleeOptions struct {
@@ -36,15 +41,14 @@ type BaseMeleeOptions struct {
 	SpawnItem           func(x, y float64) *item.Item
 }
 
+// The animations of the store are checked by CheckAnimations after loading the assets
 func NewBaseMeleeEnemy(enemyType EnemyType, pos component.Vector2D, store *animation.AnimationStore, op *BaseMeleeOptions) *BaseMeleeEnemy {
-	// Check if all needed animations exist
-	checkMeleeAnimations(store)
 	ok := store.SetCurrentAnimation(SPAWN)
 	if !ok {
 		fmt.Println("Warning: Unable to start the spawning animation")
 	}
 
-	return &BaseMeleeEnemy{
+	e := &BaseMeleeEnemy{
 		Enemy: Enemy{
 			Entity:              *entity.NewEntity(pos.X, pos.Y),
 			Speed:               op.Speed,
@@ -64,9 +68,11 @@ func NewBaseMeleeEnemy(enemyType EnemyType, pos component.Vector2D, store *anima
 		damageIndicators: make([]*DamageIndicator, 0),
 		scale:            1,
 	}
+	store.OnEvent(HIT, e.onHit)
+	return e
 }
 
-func (e *BaseMeleeEnemy) Update(player *player.Player, dt float64) {
+func (e *BaseMeleeEnemy) Update(player *player.Player, terrain TerrainProvider, dt float64) {
 	e.animationStore.Update()
 
 	/// Remove dead damageIndicators
@@ -104,14 +110,14 @@ func (e *BaseMeleeEnemy) Update(player *player.Player, dt float64) {
 
 		// The enemy does not move during the spawn animation
 		if e.animationStore.GetCurrentAnimationName() != "spawn" {
-			e.UpdateKnockback()
-			e.MoveTowards(player.Pos, dt)
+			e.UpdateKnockback(terrain)
+			e.MoveTowards(player.Pos, terrain, dt)
 
 			e.attackTimer -= dt
 			if e.Pos.Sub(player.Pos).Len() < e.AttackRange && e.attackTimer <= 0 {
-				player.Damage(e.Damage)
 				e.attackTimer = e.AttackCooldown
-				// Starting the attack animation
+				// Starting the attack animation. The damage is dealt on its impact frame.
+				e.target = player
 				e.SetAttackAnimation(player)
 			}
 		}
@@ -121,34 +127,101 @@ func (e *BaseMeleeEnemy) Update(player *player.Player, dt float64) {
 		e.animationStore.SetCurrentAnimation(DEATH)
 		// We still want to see the knockback happening
 		// It just feels way better when playing :)
-		e.UpdateKnockback()
+		e.UpdateKnockback(terrain)
 	}
 }
 
-func (e *BaseMeleeEnemy) Draw(screen *ebiten.Image, camX, camY float64) {
+// Called on the impact frame of the attack animation.
+// Players that got out of reach in the meantime dodged the attack.
+func (e *BaseMeleeEnemy) onHit(_, _ string) {
+	target := e.target
+	e.target = nil
+	if target == nil || e.Pos.Sub(target.Pos).Len() > e.AttackRange*config.ENEMY_HIT_RANGE_FACTOR {
+		return
+	}
+	// Elites hit so hard that the screen shakes
+	if e.elite {
+		target.Slam(e.Damage)
+	} else {
+		target.Damage(e.Damage)
+	}
+}
+
+func (e *BaseMeleeEnemy) Draw(screen *ebiten.Image, cam *camera.Camera) {
 	frameImage := e.animationStore.GetImage()
 	assetSize := config.DEFAULT_ENEMY_ASSET_SIZE
 	assetSizeHalf := assetSize / 2
 	if frameImage != nil {
+		if e.elite {
+			e.drawEliteGlow(screen, frameImage, cam)
+		}
 		op := &ebiten.DrawImageOptions{}
 		op.GeoM.Scale(e.scale, e.scale)
-		op.GeoM.Translate(e.Pos.X-camX-assetSizeHalf, e.Pos.Y-camY-assetSizeHalf)
-		screen.DrawImage(frameImage, op)
+		op.GeoM.Translate(e.Pos.X-assetSizeHalf, e.Pos.Y-assetSizeHalf)
+		op.GeoM.Concat(cam.GeoM())
+		drawstats.DrawImage(screen, frameImage, op)
 	} else {
 		e.DefaultDraw(
 			screen,
-			camX,
-			camY,
+			cam,
 			int(assetSize),
 			int(assetSize),
 			color.RGBA{R: 255, G: 255, B: 255, A: 255},
 		)
 	}
 	for _, dmgIndicator := range e.damageIndicators {
-		dmgIndicator.Draw(screen, camX, camY)
+		dmgIndicator.Draw(screen, cam)
 	}
 }
 
+// Draws a pulsing golden copy of the current frame behind the enemy
+// so elites stand out in a horde
+func (e *BaseMeleeEnemy) drawEliteGlow(screen *ebiten.Image, frameImage *ebiten.Image, cam *camera.Camera) {
+	assetSizeHalf := config.DEFAULT_ENEMY_ASSET_SIZE / 2
+	pulse := (math.Sin(float64(time.Now().UnixMilli())/1000*config.ELITE_GLOW_PULSE_SPEED) + 1) / 2
+	glowScale := e.scale * (1.1 + 0.1*pulse)
+	// Keep the glow centered on the scaled enemy sprite
+	centerOffset := assetSizeHalf * e.scale
+	op := &ebiten.DrawImageOptions{}
+	op.GeoM.Translate(-assetSizeHalf, -assetSizeHalf)
+	op.GeoM.Scale(glowScale, glowScale)
+	op.GeoM.Translate(e.Pos.X-assetSizeHalf+centerOffset, e.Pos.Y-assetSizeHalf+centerOffset)
+	op.GeoM.Concat(cam.GeoM())
+	op.ColorScale.Scale(1.0, 0.8, 0.2, float32(0.5+0.4*pulse))
+	op.Blend = ebiten.BlendLighter
+	drawstats.DrawImage(screen, frameImage, op)
+}
+
+// MakeElite turns the enemy into its elite variant. Elites are larger,
+// have a lot more health and drop a chest when they die.
+func (e *BaseMeleeEnemy) MakeElite() {
+	if e.elite {
+		return
+	}
+	e.elite = true
+	e.scale = config.ELITE_SCALE
+	e.Health = component.NewHealth(e.Health.MaxHP * config.ELITE_HEALTH_MULTIPLIER)
+}
+
+func (e *BaseMeleeEnemy) State() State {
+	return State{
+		Health: e.Health,
+		Speed:  e.Speed,
+		Scale:  e.scale,
+		Elite:  e.elite,
+	}
+}
+
+// SetState restores a saved enemy. Restored enemies skip
+// their spawn animation because they were already there.
+func (e *BaseMeleeEnemy) SetState(state State) {
+	e.Health = state.Health
+	e.Speed = state.Speed
+	e.scale = state.Scale
+	e.elite = state.Elite
+	e.animationStore.SetCurrentAnimation(WALK_RIGHT)
+}
+
 func (e *BaseMeleeEnemy) TakeDamage(damage float64) {
 	// Spawn a new damage indicator
 	newDmgIndicator := NewDamageIndicator(e.GetPosition(), component.NewVector2D(0, -1), damage)
@@ -156,7 +229,7 @@ func (e *BaseMeleeEnemy) TakeDamage(damage float64) {
 	e.Health.Damage(damage)
 }
 
-func (e *BaseMeleeEnemy) TryDrop(elapsedMinutes float32) []item.Item {
+func (e *BaseMeleeEnemy) TryDrop(rng *rand.Rand, elapsedMinutes float32) []item.Item {
 	prob := e.DropProb + elapsedMinutes*0.001 // +0.1% per minute, // so +1% per 10 minutes
 	if prob > 1 {
 		prob = 1
@@ -165,11 +238,15 @@ func (e *BaseMeleeEnemy) TryDrop(elapsedMinutes float32) []item.Item {
 	amount := e.DropAmount + int(elapsedMinutes*e.DropAmountPerMinute)
 
 	var drops []item.Item
-	if rand.Float32() < prob {
+	if rng.Float32() < prob {
 		for i := 0; i < amount; i++ {
 			drops = append(drops, *e.spawnItem(e.Pos.X, e.Pos.Y))
 		}
 	}
+	// Elites always drop a chest
+	if e.elite {
+		drops = append(drops, *item.NewChest(e.Pos.X, e.Pos.Y))
+	}
 	return drops
 }
 
@@ -210,14 +287,7 @@ func (e *BaseMeleeEnemy) IsAlive() bool {
 
 var _ EnemyInterface = (*BaseMeleeEnemy)(nil)
 
-func doesAnimationExist(store *animation.AnimationStore, name string) {
-	_, ok := store.GetAnimation(name)
-	if !ok {
-		panic("Warning: Missing Animation: " + name)
-	}
-}
-
-func checkMeleeAnimations(store *animation.AnimationStore) {
+func checkMeleeAnimations(store *animation.AnimationStore) error {
 	animNames := []string{
 		WALK_RIGHT,
 		WALK_LEFT,
@@ -226,7 +296,19 @@ func checkMeleeAnimations(store *animation.AnimationStore) {
 		SPAWN,
 		DEATH,
 	}
+	var errs []error
 	for _, name := range animNames {
-		doesAnimationExist(store, name)
+		if _, ok := store.GetAnimation(name); !ok {
+			errs = append(errs, fmt.Errorf("missing animation: %s", name))
+		}
+	}
+	// Without the impact frame the attacks would never deal damage
+	for _, name := range []string{ATTACK_RIGHT, ATTACK_LEFT} {
+		if anim, ok := store.GetAnimation(name); ok {
+			if _, ok := anim.Events[HIT]; !ok {
+				errs = append(errs, fmt.Errorf("the animation %s has no %s event", name, HIT))
+			}
+		}
 	}
+	return errors.Join(errs...)
 }
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/N3moAhead/harvest/internal/assets"
+	"github.com/N3moAhead/harvest/internal/camera"
 	"github.com/N3moAhead/harvest/internal/component"
 	"github.com/N3moAhead/harvest/internal/config"
 	"github.com/hajimehoshi/ebiten/v2"
@@ -45,10 +46,12 @@ func (d *DamageIndicator) Update(pos component.Vector2D) (isAlive bool) {
 	return true
 }
 
-func (d *DamageIndicator) Draw(screen *ebiten.Image, camX, camY float64) {
+func (d *DamageIndicator) Draw(screen *ebiten.Image, cam *camera.Camera) {
 	if d.Font != nil {
-		textY := d.Pos.Y + d.Offset.Y - camY + float64(d.Font.Metrics().Ascent/64)
-		text.Draw(screen, fmt.Sprintf("%d", int(d.Damage)), d.Font, int(d.Pos.X-camX+d.Offset.X), int(textY), color.White)
+		// Only the position follows the zoom. The text keeps its size so it stays readable.
+		screenPos := cam.WorldToScreen(d.Pos)
+		textY := screenPos.Y + d.Offset.Y + float64(d.Font.Metrics().Ascent/64)
+		text.Draw(screen, fmt.Sprintf("%d", int(d.Damage)), d.Font, int(screenPos.X+d.Offset.X), int(textY), color.White)
 	} else {
 		fmt.Println("Warning: Missing font in damage indicator Draw")
 	}
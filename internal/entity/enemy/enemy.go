@@ -2,10 +2,13 @@ package enemy
 
 import (
 	"image/color"
+	"math"
 	"math/rand"
 
 	"github.com/N3moAhead/harvest/internal/animation"
+	"github.com/N3moAhead/harvest/internal/camera"
 	"github.com/N3moAhead/harvest/internal/component"
+	"github.com/N3moAhead/harvest/internal/config"
 	"github.com/N3moAhead/harvest/internal/entity"
 	"github.com/N3moAhead/harvest/internal/entity/item"
 	"github.com/N3moAhead/harvest/internal/entity/player"
@@ -13,16 +16,46 @@ import (
 	"github.com/hajimehoshi/ebiten/v2/vector"
 )
 
+// Gives enemies the information where they are allowed to walk
+type TerrainProvider interface {
+	MoveAndSlide(box component.Rect, delta component.Vector2D) component.Vector2D
+	FlowDirectionAt(pos component.Vector2D) (dir component.Vector2D, ok bool)
+}
+
+const (
+	// If an enemy moves less than this share of its step it counts as blocked
+	minSteeringProgress = 0.5
+	// Enemies closer to their target than this walk straight at it instead of following the flow field
+	directChaseDistance = 2 * config.TILE_SIZE
+)
+
+// Angles an enemy tries when its blocked by an obstacle, in order.
+// They get mirrored by the side the enemy is currently steering to.
+var steeringAngles = []float64{math.Pi / 4, math.Pi / 2, 3 * math.Pi / 4}
+
 type EnemyInterface interface {
-	Update(player *player.Player, dt float64)
-	Draw(screen *ebiten.Image, camX, camY float64)
+	Update(player *player.Player, terrain TerrainProvider, dt float64)
+	Draw(screen *ebiten.Image, cam *camera.Camera)
 	GetPosition() component.Vector2D
 	SetPosition(pos component.Vector2D)
 	IsAlive() bool
 	TakeDamage(damage float64)
 	AddKnockback(from *component.Vector2D, distance float64)
-	TryDrop(elapsedMinutes float32) []item.Item
+	TryDrop(rng *rand.Rand, elapsedMinutes float32) []item.Item
 	GetType() EnemyType
+	MakeElite()
+	IsElite() bool
+	State() State
+	SetState(state State)
+}
+
+// State is everything about an enemy that changes while it is alive.
+// It is used to save a running game.
+type State struct {
+	Health component.Health
+	Speed  float64
+	Scale  float64
+	Elite  bool
 }
 
 type EnemyType int
@@ -76,37 +109,92 @@ type Enemy struct {
 	DropProb            float32
 	DropAmount          int
 	DropAmountPerMinute float32
+	elite               bool
+	steerSide           float64 // 1 or -1, the side the enemy walks around obstacles
 }
 
-func (e *Enemy) MoveTowards(target component.Vector2D, dt float64) {
+// MoveTowards walks the enemy towards the target and slides along obstacles.
+// Far away from the target the enemy follows the flow field of the terrain which
+// leads around obstacles. The flow field has to point to the target.
+// If the enemy gets stuck it tries to walk around the obstacle by turning
+// away from the target. It keeps turning to the same side until it is free
+// again so it does not jitter in front of walls.
+func (e *Enemy) MoveTowards(target component.Vector2D, terrain TerrainProvider, dt float64) {
 	diff := target.Sub(e.Pos)
 	dist := diff.Len()
+	if dist == 0 {
+		return
+	}
+
+	if terrain == nil {
+		step := diff.Normalize().Mul(e.Speed * dt)
+		if step.Len() > dist { // if overstep, set to target
+			step = diff
+		}
+		e.Pos = e.Pos.Add(step)
+		return
+	}
 
 	dir := diff.Normalize()
+	if dist > directChaseDistance {
+		if flowDir, ok := terrain.FlowDirectionAt(e.Pos); ok {
+			dir = flowDir
+		}
+	}
 	step := dir.Mul(e.Speed * dt)
-
 	if step.Len() > dist { // if overstep, set to target
-		e.Pos = target
+		step = diff
+	}
+
+	moved := terrain.MoveAndSlide(e.Hitbox(), step)
+	if moved.Len() < step.Len()*minSteeringProgress {
+		if e.steerSide == 0 {
+			e.steerSide = 1
+			if rand.Intn(2) == 0 {
+				e.steerSide = -1
+			}
+		}
+		for _, angle := range steeringAngles {
+			steered := terrain.MoveAndSlide(e.Hitbox(), step.Rotate(angle*e.steerSide))
+			if steered.Len() > moved.Len() {
+				moved = steered
+			}
+			if moved.Len() >= step.Len()*minSteeringProgress {
+				break
+			}
+		}
 	} else {
-		e.Pos = e.Pos.Add(step)
+		e.steerSide = 0
 	}
+	e.Pos = e.Pos.Add(moved)
+}
+
+// Hitbox returns the box of the enemy that collides with obstacles
+func (e *Enemy) Hitbox() component.Rect {
+	return component.NewRectAround(e.Pos, config.ENEMY_HITBOX_SIZE, config.ENEMY_HITBOX_SIZE)
 }
 
 func (e *Enemy) AddKnockback(from *component.Vector2D, dist float64) {
 	e.Knockback.Init(from, &e.Pos, dist)
 }
 
-func (e *Enemy) UpdateKnockback() {
-	e.Knockback.Update(&e.Pos)
+// Knockbacks can not push enemies into obstacles
+func (e *Enemy) UpdateKnockback(terrain TerrainProvider) {
+	target := e.Pos
+	e.Knockback.Update(&target)
+	delta := target.Sub(e.Pos)
+	if terrain != nil {
+		delta = terrain.MoveAndSlide(e.Hitbox(), delta)
+	}
+	e.Pos = e.Pos.Add(delta)
 }
 
-func (e *Enemy) DefaultDraw(screen *ebiten.Image, camX, camY float64, width int, height int, color color.RGBA) {
-	x := float32(e.Pos.X - camX)
-	y := float32(e.Pos.Y - camY)
+func (e *Enemy) DefaultDraw(screen *ebiten.Image, cam *camera.Camera, width int, height int, color color.RGBA) {
+	screenPos := cam.WorldToScreen(e.Pos)
 	vector.DrawFilledRect(
 		screen,
-		x, y,
-		float32(width), float32(height),
+		float32(screenPos.X), float32(screenPos.Y),
+		float32(float64(width)*cam.Scale()), float32(float64(height)*cam.Scale()),
 		color,
 		false,
 	)
@@ -132,6 +220,11 @@ func (e *Enemy) GetType() EnemyType {
 	return e.enemyType
 }
 
+// Elites are stronger variants of an enemy that drop a chest on death
+func (e *Enemy) IsElite() bool {
+	return e.elite
+}
+
 func DefaultDrop(elapsedMinutes float32, x, y float64) []item.Item {
 	return []item.Item{*item.NewPotato(x, y)} // default drop is a potato
 }
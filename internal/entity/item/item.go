@@ -4,7 +4,9 @@ import (
 	"fmt"
 
 	"github.com/N3moAhead/harvest/internal/assets"
+	"github.com/N3moAhead/harvest/internal/camera"
 	"github.com/N3moAhead/harvest/internal/config"
+	"github.com/N3moAhead/harvest/internal/drawstats"
 	"github.com/N3moAhead/harvest/internal/entity"
 	"github.com/N3moAhead/harvest/internal/entity/item/itemtype"
 	"github.com/N3moAhead/harvest/internal/entity/player"
@@ -39,16 +41,17 @@ func (i *Item) Update(player *player.Player) (itemPickedUp bool) {
 	return false
 }
 
-func (i *Item) Draw(screen *ebiten.Image, mapOffsetX float64, mapOffsetY float64) {
+func (i *Item) Draw(screen *ebiten.Image, cam *camera.Camera) {
 	scaleFactor := config.ICON_ON_MAP_RENDER_SIZE / config.ICON_SIZE
 	op := &ebiten.DrawImageOptions{}
 	op.GeoM.Scale(scaleFactor, scaleFactor)
 	scaledIconHalfWidth := (config.ICON_SIZE * scaleFactor) / 2.0
 	scaledIconHalfHeight := (config.ICON_SIZE * scaleFactor) / 2.0
-	drawX := i.Pos.X - mapOffsetX - scaledIconHalfWidth
-	drawY := i.Pos.Y - mapOffsetY - scaledIconHalfHeight
+	drawX := i.Pos.X - scaledIconHalfWidth
+	drawY := i.Pos.Y - scaledIconHalfHeight
 	op.GeoM.Translate(drawX, drawY)
-	screen.DrawImage(i.Icon, op)
+	op.GeoM.Concat(cam.GeoM())
+	drawstats.DrawImage(screen, i.Icon, op)
 }
 
 func newItemBase(posX float64, posY float64, itemType itemtype.ItemType) *Item {
@@ -102,3 +105,7 @@ func (i *Item) IsWeapon() bool {
 func (i *Item) IsSoup() bool {
 	return i.CategoryOf() == itemtype.CategorySoup
 }
+
+func (i *Item) IsChest() bool {
+	return i.CategoryOf() == itemtype.CategoryChest
+}
@@ -92,4 +92,10 @@ var ItemInfos = map[itemtype.ItemType]ItemInfo{
 		Soup:        soups.Definitions[itemtype.SpeedSoup],
 		IconName:    "soup_icon3",
 	},
+	itemtype.Chest: {
+		DisplayName: "Chest",
+		Category:    itemtype.CategoryChest,
+		Soup:        nil,
+		IconName:    "chest_icon",
+	},
 }
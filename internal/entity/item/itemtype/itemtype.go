@@ -7,6 +7,7 @@ const (
 	CategoryVegetable
 	CategoryWeapon
 	CategorySoup
+	CategoryChest
 )
 
 func (ic ItemCategory) String() string {
@@ -19,6 +20,8 @@ func (ic ItemCategory) String() string {
 		return "Weapon"
 	case CategorySoup:
 		return "Soup"
+	case CategoryChest:
+		return "Chest"
 	default:
 		return "Unknown"
 	}
@@ -41,6 +44,7 @@ const (
 	DamageSoup
 	MagnetRadiusSoup
 	SpeedSoup
+	Chest
 	MaxItemType // This should always be the last item type
 )
 
@@ -74,6 +78,8 @@ func (it ItemType) String() string {
 		return "Throwing Knifes"
 	case SpeedSoup:
 		return "Speed Soup"
+	case Chest:
+		return "Chest"
 	default:
 		return "Unknown"
 	}
@@ -87,6 +93,8 @@ func (it ItemType) Category() ItemCategory {
 		return CategoryWeapon
 	case DamageSoup, MagnetRadiusSoup, SpeedSoup:
 		return CategorySoup
+	case Chest:
+		return CategoryChest
 	default:
 		return CategoryUndefined
 	}
@@ -4,6 +4,11 @@ import (
 	"github.com/N3moAhead/harvest/internal/entity/item/itemtype"
 )
 
+// NewItem creates an item of any type. It is used to recreate saved items.
+func NewItem(itemType itemtype.ItemType, posX, posY float64) *Item {
+	return newItemBase(posX, posY, itemType)
+}
+
 /// --- Vegtables ---
 
 func NewCarrot(posX float64, posY float64) *Item {
@@ -59,3 +64,9 @@ func NewSoup(x, y float64, typeBuff itemtype.ItemType) *Item {
 	newItem := newItemBase(x, y, typeBuff)
 	return newItem
 }
+
+/// --- Chests ---
+
+func NewChest(posX, posY float64) *Item {
+	return newItemBase(posX, posY, itemtype.Chest)
+}
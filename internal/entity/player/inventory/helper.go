@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"github.com/N3moAhead/harvest/internal/assets"
+	"github.com/N3moAhead/harvest/internal/drawstats"
 	"github.com/N3moAhead/harvest/internal/entity/item"
 	"github.com/N3moAhead/harvest/internal/entity/item/itemtype"
 	"github.com/hajimehoshi/ebiten/v2"
@@ -37,6 +38,6 @@ func drawItemDisplay(screen *ebiten.Image, itemType itemtype.ItemType, amount in
 	itemIcon := getItemIcon(itemInfo)
 	op := &ebiten.DrawImageOptions{}
 	op.GeoM.Translate(10, 30+offset)
-	screen.DrawImage(itemIcon, op)
+	drawstats.DrawImage(screen, itemIcon, op)
 	ebitenutil.DebugPrintAt(screen, fmt.Sprintf("%d", amount), 17, int(35+offset))
 }
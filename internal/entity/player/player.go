@@ -1,19 +1,22 @@
 package player
 
 import (
+	"errors"
 	"fmt"
 	"image/color"
+	"math"
 	"time"
 
 	"github.com/N3moAhead/harvest/internal/animation"
 	"github.com/N3moAhead/harvest/internal/assets"
+	"github.com/N3moAhead/harvest/internal/camera"
 	"github.com/N3moAhead/harvest/internal/component"
 	"github.com/N3moAhead/harvest/internal/config"
+	"github.com/N3moAhead/harvest/internal/drawstats"
 	"github.com/N3moAhead/harvest/internal/entity"
 	"github.com/N3moAhead/harvest/internal/entity/item/itemtype"
 	"github.com/N3moAhead/harvest/internal/input"
 	"github.com/N3moAhead/harvest/internal/soups"
-	"github.com/N3moAhead/harvest/pkg/util"
 	"github.com/hajimehoshi/ebiten/v2"
 	"github.com/hajimehoshi/ebiten/v2/vector"
 )
@@ -22,6 +25,11 @@ type InventoryProvider interface {
 	RemoveAllSoups(soupType itemtype.ItemType)
 }
 
+// Gives the player the information where it is allowed to walk
+type TerrainProvider interface {
+	MoveAndSlide(box component.Rect, delta component.Vector2D) component.Vector2D
+}
+
 type Player struct {
 	entity.Entity
 	Soups            []soups.Soup
@@ -32,6 +40,8 @@ type Player struct {
 	Health           component.Health
 	FacingDirection  component.Vector2D
 	animationStore   *animation.AnimationStore
+	// Called after the player got hit. heavy is true for slams of elite enemies.
+	OnDamage func(amount float64, heavy bool)
 }
 
 const (
@@ -46,9 +56,28 @@ const (
 	UP_LEFT    = "up_left"
 )
 
+// The name of the player animations in the animation definitions
+const animationSet = "player"
+
+// CheckAnimations makes sure the player has an animation for every direction.
+// It is called once after loading the assets.
+func CheckAnimations() error {
+	store, ok := assets.AssetStore.GetAnimations(animationSet)
+	if !ok {
+		return errors.New("the animations of the player are missing")
+	}
+	var errs []error
+	for _, name := range []string{IDLE, UP, UP_RIGHT, RIGHT, DOWN_RIGHT, DOWN, DOWN_LEFT, LEFT, UP_LEFT} {
+		if _, ok := store.GetAnimation(name); !ok {
+			errs = append(errs, fmt.Errorf("player: missing animation: %s", name))
+		}
+	}
+	return errors.Join(errs...)
+}
+
 // The player is currently just drawn as a rectangle.
 // TODO: Draw the player with assets
-func (p *Player) Draw(screen *ebiten.Image, mapOffsetX float64, mapOffsetY float64) {
+func (p *Player) Draw(screen *ebiten.Image, cam *camera.Camera) {
 	// TODO move the player rect size to the config or somewhere else
 	rectSize := 32.0
 	var halfRectSize float64 = rectSize / 2
@@ -57,16 +86,18 @@ func (p *Player) Draw(screen *ebiten.Image, mapOffsetX float64, mapOffsetY float
 
 	if playerImg != nil {
 		op := &ebiten.DrawImageOptions{}
-		op.GeoM.Translate(p.Pos.X-mapOffsetX-halfRectSize, p.Pos.Y-mapOffsetY-halfRectSize)
-		screen.DrawImage(playerImg, op)
+		op.GeoM.Translate(p.Pos.X-halfRectSize, p.Pos.Y-halfRectSize)
+		op.GeoM.Concat(cam.GeoM())
+		drawstats.DrawImage(screen, playerImg, op)
 	} else {
 		// Fallback if player image could not be loaded
+		screenPos := cam.WorldToScreen(p.Pos.Sub(component.NewVector2D(halfRectSize, halfRectSize)))
 		vector.DrawFilledRect(
 			screen,
-			float32(p.Pos.X)-float32(mapOffsetX)-float32(halfRectSize),
-			float32(p.Pos.Y)-float32(mapOffsetY)-float32(halfRectSize),
-			float32(rectSize),
-			float32(rectSize),
+			float32(screenPos.X),
+			float32(screenPos.Y),
+			float32(rectSize*cam.Scale()),
+			float32(rectSize*cam.Scale()),
 			color.RGBA{R: 255, G: 255, B: 255, A: 255},
 			true,
 		)
@@ -83,6 +114,11 @@ func (p *Player) GetFacingDirection() component.Vector2D {
 	return p.FacingDirection
 }
 
+// Hitbox returns the box of the player that collides with obstacles
+func (p *Player) Hitbox() component.Rect {
+	return component.NewRectAround(p.Pos, config.PLAYER_HITBOX_SIZE, config.PLAYER_HITBOX_SIZE)
+}
+
 func (p *Player) ExtendOrAddSoup(soup *soups.Soup) {
 	now := time.Now()
 
@@ -107,61 +143,39 @@ func (p *Player) ExtendOrAddSoup(soup *soups.Soup) {
 	p.Soups = append(p.Soups, newSoup)
 }
 
-func (p *Player) Update(inputState *input.InputState, dt float64, inventory InventoryProvider) { //TODO maybe add inventory to player struct?
-	now := time.Now()
-	p.animationStore.Update()
-
-	// Update player position
-	moveDir := component.Vector2D{X: 0, Y: 0}
-	moved := false
+// The walking animations in clockwise order starting with right.
+// The y axis points down so the order goes from right to down.
+var directionAnimations = [8]string{RIGHT, DOWN_RIGHT, DOWN, DOWN_LEFT, LEFT, UP_LEFT, UP, UP_RIGHT}
 
-	currentAnimation := IDLE
-	if inputState.Up {
-		moveDir.Y -= 1
-		moved = true
-		currentAnimation = UP
-	}
-	if inputState.Down {
-		moveDir.Y += 1
-		moved = true
-		currentAnimation = DOWN
-	}
-	if inputState.Left {
-		moveDir.X -= 1
-		moved = true
-		currentAnimation = LEFT
-	}
-	if inputState.Right {
-		moveDir.X += 1
-		moved = true
-		currentAnimation = RIGHT
-	}
-	if inputState.Up && inputState.Right {
-		currentAnimation = UP_RIGHT
-	}
-	if inputState.Up && inputState.Left {
-		currentAnimation = UP_LEFT
-	}
-	if inputState.Down && inputState.Right {
-		currentAnimation = DOWN_RIGHT
-	}
-	if inputState.Down && inputState.Left {
-		currentAnimation = DOWN_LEFT
+// Returns the animation of the direction closest to the move direction
+func directionAnimation(move component.Vector2D) string {
+	if move.LengthSq() == 0 {
+		return IDLE
 	}
+	sector := int(math.Round(math.Atan2(move.Y, move.X) / (math.Pi / 4)))
+	return directionAnimations[(sector+8)%8]
+}
 
-	if moveDir.Y != 0 && moveDir.X != 0 {
-		moveDir = moveDir.Normalize()
-		moved = true
-	}
+func (p *Player) Update(inputState *input.InputState, dt float64, inventory InventoryProvider, terrain TerrainProvider) { //TODO maybe add inventory to player struct?
+	now := time.Now()
+	p.animationStore.Update()
 
-	p.animationStore.SetCurrentAnimation(currentAnimation)
+	// Update player position. Analog sticks that are only tilted
+	// a bit move the player slower.
+	moveDir := inputState.Move
+	moved := moveDir.LengthSq() > 0
+	p.animationStore.SetCurrentAnimation(directionAnimation(moveDir))
 
 	// If the player moved update the facingDirection and the player position
 	if moved {
-		p.Pos = p.Pos.Add(moveDir.Mul(p.Speed))
-		p.Pos.X = util.Clamp(p.Pos.X, 16, config.WIDTH_IN_TILES*config.TILE_SIZE-16)
-		p.Pos.Y = util.Clamp(p.Pos.Y, 16, config.HEIGHT_IN_TILES*config.TILE_SIZE-16)
-		p.FacingDirection = moveDir
+		delta := moveDir.Mul(p.Speed)
+		// Obstacles like water or fences block the player.
+		// Running diagonally into them lets the player slide along.
+		if terrain != nil {
+			delta = terrain.MoveAndSlide(p.Hitbox(), delta)
+		}
+		p.Pos = p.Pos.Add(delta)
+		p.FacingDirection = moveDir.Normalize()
 	}
 
 	// Update soups
@@ -192,12 +206,20 @@ func (p *Player) Update(inputState *input.InputState, dt float64, inventory Inve
 }
 
 func (p *Player) Damage(amount float64) {
-	hitSound, ok := assets.AssetStore.GetSFXData("player_hit_sound")
-	if ok {
-		sfxPlayer := assets.AudioContext.NewPlayerFromBytes(hitSound)
-		sfxPlayer.Play()
-	}
+	p.takeDamage(amount, false)
+}
+
+// Slam damages the player like Damage but counts as a heavy hit
+func (p *Player) Slam(amount float64) {
+	p.takeDamage(amount, true)
+}
+
+func (p *Player) takeDamage(amount float64, heavy bool) {
+	assets.PlaySound("player_hit_sound")
 	p.Health.Damage(amount)
+	if p.OnDamage != nil {
+		p.OnDamage(amount, heavy)
+	}
 }
 
 func (p *Player) Alive() bool {
@@ -216,54 +238,14 @@ func (p *Player) HasSoup(soupType itemtype.ItemType) bool {
 // TODO: implement a LoadPlayer function to get the saved
 // game state from the past
 func NewPlayer(playerLvl uint) *Player {
-	baseEntity := entity.NewEntity(
-		(config.WIDTH_IN_TILES*config.TILE_SIZE)/2,
-		(config.HEIGHT_IN_TILES*config.TILE_SIZE)/2,
-	)
-
-	store := animation.NewAnimationStore()
-	playerImg, ok := assets.AssetStore.GetImage("chef_walk")
-	playerIdleImg, ok := assets.AssetStore.GetImage("chef_Idle")
-	if ok {
-		up, err := animation.NewAnimation(playerImg, 32, 32, 0, 0, 6, 6, true)
-		if err == nil {
-			store.AddAnimation(UP, up)
-		}
-		upRight, err := animation.NewAnimation(playerImg, 32, 32, 0, 2*32, 6, 6, true)
-		if err == nil {
-			store.AddAnimation(UP_RIGHT, upRight)
-		}
-		right, err := animation.NewAnimation(playerImg, 32, 32, 0, 2*32, 6, 6, true)
-		if err == nil {
-			store.AddAnimation(RIGHT, right)
-		}
-		downRight, err := animation.NewAnimation(playerImg, 32, 32, 0, 2*32, 6, 6, true)
-		if err == nil {
-			store.AddAnimation(DOWN_RIGHT, downRight)
-		}
-		down, err := animation.NewAnimation(playerImg, 32, 32, 0, 1*32, 6, 6, true)
-		if err == nil {
-			store.AddAnimation(DOWN, down)
-		}
-		downLeft, err := animation.NewAnimation(playerImg, 32, 32, 0, 3*32, 6, 6, true)
-		if err == nil {
-			store.AddAnimation(DOWN_LEFT, downLeft)
-		}
-		left, err := animation.NewAnimation(playerImg, 32, 32, 0, 3*32, 6, 6, true)
-		if err == nil {
-			store.AddAnimation(LEFT, left)
-		}
-		upLeft, err := animation.NewAnimation(playerImg, 32, 32, 0, 3*32, 6, 6, true)
-		if err == nil {
-			store.AddAnimation(UP_LEFT, upLeft)
-		}
-		idle, err := animation.NewAnimation(playerIdleImg, 32, 32, 0, 0, 2, 24, true)
-		if err == nil {
-			store.AddAnimation(IDLE, idle)
-		}
-		store.SetCurrentAnimation(IDLE)
-	} else {
-		fmt.Println("Warning: Could not load player img in NewPlayer()")
+	// The player starts at the origin of the world
+	baseEntity := entity.NewEntity(0, 0)
+
+	// The idle animation is the default animation of the player
+	store, ok := assets.AssetStore.GetAnimations(animationSet)
+	if !ok {
+		fmt.Println("Warning: Could not load the player animations in NewPlayer()")
+		store = animation.NewAnimationStore()
 	}
 
 	playerLvlFactor := config.PLAYER_LEVEL_FACTOR
@@ -23,12 +23,13 @@ func NewKnifeProjectile(
 	hitRadius float64,
 	pierce int,
 	knockback float64,
+	bounces int,
 ) *KnifeProjectile {
 	knifeImg, ok := assets.AssetStore.GetImage("knife_projectile")
 	if !ok {
 		fmt.Println("Warning: Could not load knifeImg in NewKnifeProjectile")
 	}
-	return &KnifeProjectile{
+	knife := &KnifeProjectile{
 		BaseProjectile: *NewBaseProjectile(
 			pos,
 			dir,
@@ -42,6 +43,8 @@ func NewKnifeProjectile(
 			"knife_throw_impact",
 		),
 	}
+	knife.Bounces = bounces
+	return knife
 }
 
 func (k *KnifeProjectile) PlayImpactSound() {
@@ -6,15 +6,22 @@ import (
 	"time"
 
 	"github.com/N3moAhead/harvest/internal/assets"
+	"github.com/N3moAhead/harvest/internal/camera"
 	"github.com/N3moAhead/harvest/internal/collision"
 	"github.com/N3moAhead/harvest/internal/component"
+	"github.com/N3moAhead/harvest/internal/drawstats"
 	"github.com/N3moAhead/harvest/internal/entity/enemy"
 	"github.com/hajimehoshi/ebiten/v2"
 )
 
+// Gives projectiles the information where they hit a wall
+type TerrainProvider interface {
+	IsSolidAt(pos component.Vector2D) bool
+}
+
 type Projectile interface {
-	Draw(screen *ebiten.Image, camX, camY float64)
-	Update(enemies []enemy.EnemyInterface) (active bool)
+	Draw(screen *ebiten.Image, cam *camera.Camera)
+	Update(enemies []enemy.EnemyInterface, terrain TerrainProvider) (active bool)
 	PlayImpactSound()
 }
 
@@ -28,6 +35,7 @@ type BaseProjectile struct {
 	Pierce          int
 	HittedEnemies   int
 	Knockback       float64
+	Bounces         int                           // How often the projectile bounces off of walls before it stops
 	alreadyPierced  map[enemy.EnemyInterface]bool // making sure enemies are just getting pierced once
 	FlyUntil        time.Time
 	ImpactSoundName string
@@ -61,7 +69,7 @@ func NewBaseProjectile(
 	}
 }
 
-func (b *BaseProjectile) Update(enemies []enemy.EnemyInterface) (active bool) {
+func (b *BaseProjectile) Update(enemies []enemy.EnemyInterface, terrain TerrainProvider) (active bool) {
 	if time.Now().After(b.FlyUntil) {
 		return false // The Projectile has run out of time
 	}
@@ -80,32 +88,58 @@ func (b *BaseProjectile) Update(enemies []enemy.EnemyInterface) (active bool) {
 
 		enemy.TakeDamage(b.Damage)
 		enemy.AddKnockback(&b.Pos, b.Knockback)
-		impactSound, ok := assets.AssetStore.GetSFXData(b.ImpactSoundName)
-		if ok {
-			sfxPlayer := assets.AudioContext.NewPlayerFromBytes(impactSound)
-			sfxPlayer.Play()
-		}
+		assets.PlaySound(b.ImpactSoundName)
 
 		b.HittedEnemies++
 		b.alreadyPierced[enemy] = true
 	}
 
-	b.Pos = b.Pos.Add(b.Dir.Mul(b.Speed))
+	newPos := b.Pos.Add(b.Dir.Mul(b.Speed))
+	if terrain != nil && terrain.IsSolidAt(newPos) {
+		if b.Bounces <= 0 {
+			return false // The projectile got stuck in a wall
+		}
+		b.bounce(terrain)
+		newPos = b.Pos.Add(b.Dir.Mul(b.Speed))
+		// In tight spots the reflected move can be blocked as well.
+		// The projectile waits in front of the wall and bounces again next tick.
+		if terrain.IsSolidAt(newPos) {
+			return true
+		}
+	}
+	b.Pos = newPos
 	return true
 }
 
-func (b *BaseProjectile) Draw(screen *ebiten.Image, camX, camY float64) {
+// Reflects the direction on the axis that hit the wall.
+// If both or none of the single axis moves are blocked the
+// projectile hit a corner and flies back where it came from.
+func (b *BaseProjectile) bounce(terrain TerrainProvider) {
+	step := b.Dir.Mul(b.Speed)
+	blockedX := terrain.IsSolidAt(component.NewVector2D(b.Pos.X+step.X, b.Pos.Y))
+	blockedY := terrain.IsSolidAt(component.NewVector2D(b.Pos.X, b.Pos.Y+step.Y))
+	switch {
+	case blockedX && !blockedY:
+		b.Dir.X = -b.Dir.X
+	case blockedY && !blockedX:
+		b.Dir.Y = -b.Dir.Y
+	default:
+		b.Dir = b.Dir.Mul(-1)
+	}
+	b.Bounces--
+}
+
+func (b *BaseProjectile) Draw(screen *ebiten.Image, cam *camera.Camera) {
 	op := &ebiten.DrawImageOptions{}
 	rotation := math.Atan2(b.Dir.Y, b.Dir.X)
 	bounds := b.Img.Bounds()
 	pivotX := bounds.Dx() / 2
 	pivotY := bounds.Dy() / 2
-	drawX := b.Pos.X - camX
-	drawY := b.Pos.Y - camY
 	op.GeoM.Translate(-float64(pivotX), -float64(pivotY))
 	op.GeoM.Rotate(rotation)
-	op.GeoM.Translate(drawX, drawY)
-	screen.DrawImage(b.Img, op)
+	op.GeoM.Translate(b.Pos.X, b.Pos.Y)
+	op.GeoM.Concat(cam.GeoM())
+	drawstats.DrawImage(screen, b.Img, op)
 }
 
 func (b *BaseProjectile) PlayImpactSound() {
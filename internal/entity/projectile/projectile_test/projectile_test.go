@@ -0,0 +1,65 @@
+package projectile_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/N3moAhead/harvest/internal/component"
+	"github.com/N3moAhead/harvest/internal/entity/projectile"
+)
+
+// Terrain that is solid wherever the function returns true
+type terrainFunc func(pos component.Vector2D) bool
+
+func (f terrainFunc) IsSolidAt(pos component.Vector2D) bool {
+	return f(pos)
+}
+
+func newProjectile(pos, dir component.Vector2D, bounces int) *projectile.BaseProjectile {
+	p := projectile.NewBaseProjectile(pos, dir, 5, nil, time.Minute, 1, 1, 1, 0, "")
+	p.Bounces = bounces
+	return p
+}
+
+func TestProjectileBouncesOffAWall(t *testing.T) {
+	wall := terrainFunc(func(pos component.Vector2D) bool { return pos.X >= 10 })
+	p := newProjectile(component.NewVector2D(8, 0), component.NewVector2D(1, 0), 1)
+
+	if !p.Update(nil, wall) {
+		t.Fatal("Expected the projectile to bounce")
+	}
+	if p.Pos.X != 3 || p.Dir.X != -1 {
+		t.Errorf("Expected the projectile to fly back to 3 with direction -1, got %v with %v", p.Pos.X, p.Dir.X)
+	}
+	if p.Bounces != 0 {
+		t.Errorf("Expected the bounce to be used up, got %d", p.Bounces)
+	}
+}
+
+func TestProjectileStopsInAWallWithoutBounces(t *testing.T) {
+	wall := terrainFunc(func(pos component.Vector2D) bool { return pos.X >= 10 })
+	p := newProjectile(component.NewVector2D(8, 0), component.NewVector2D(1, 0), 0)
+
+	if p.Update(nil, wall) {
+		t.Error("Expected the projectile to stop at the wall")
+	}
+}
+
+func TestProjectileDoesNotBounceIntoAnotherWall(t *testing.T) {
+	// Everything but the start position is solid so the reflected move is blocked too
+	start := component.NewVector2D(0, 0)
+	cell := terrainFunc(func(pos component.Vector2D) bool { return pos != start })
+	p := newProjectile(start, component.NewVector2D(1, 0), 3)
+
+	for tick := 0; p.Update(nil, cell); tick++ {
+		if p.Pos != start {
+			t.Fatalf("Expected the projectile to stay out of the walls, got %v", p.Pos)
+		}
+		if tick > 10 {
+			t.Fatal("Expected the projectile to stop after its bounces are used up")
+		}
+	}
+	if p.Bounces != 0 {
+		t.Errorf("Expected all bounces to be used up, got %d", p.Bounces)
+	}
+}
@@ -26,9 +26,6 @@ func NewInventoryDisplay(x, y float64, invRef *inventory.Inventory) *InventoryDi
 		inv:       invRef,
 	}
 
-	inventoryDisplay.Width = config.ITEM_FRAME_SIZE
-	inventoryDisplay.Height = config.ITEM_FRAME_SIZE * config.VEGTABLE_TYPE_AMOUNT * config.SOUP_TYPE_AMOUNT
-
 	for range config.VEGTABLE_TYPE_AMOUNT {
 		// The position does not matter because the container will set it for us
 		// after adding the vegtable dispaly to it
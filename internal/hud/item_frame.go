@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"github.com/N3moAhead/harvest/internal/config"
+	"github.com/N3moAhead/harvest/internal/drawstats"
 	"github.com/N3moAhead/harvest/internal/entity/item/itemtype"
 	"github.com/N3moAhead/harvest/internal/entity/player/inventory"
 	"github.com/N3moAhead/harvest/pkg/ui"
@@ -62,7 +63,7 @@ func (v *ItemDisplay) Draw(screen *ebiten.Image) {
 	// Drawing the frame
 	op := &ebiten.DrawImageOptions{}
 	op.GeoM.Translate(v.X, v.Y)
-	screen.DrawImage(v.ItemFrameImg, op)
+	drawstats.DrawImage(screen, v.ItemFrameImg, op)
 	if v.itemType != itemtype.Undefined && v.amount != 0 {
 		v.drawItemDisplay(screen)
 	}
@@ -78,7 +79,7 @@ func (v *ItemDisplay) drawItemDisplay(screen *ebiten.Image) {
 	drawY := v.Y + offsetY
 	op := &ebiten.DrawImageOptions{}
 	op.GeoM.Translate(drawX, drawY)
-	screen.DrawImage(itemIcon, op)
+	drawstats.DrawImage(screen, itemIcon, op)
 	// TODO replace the debug print with a real font!!
 	// Adding some padding here to move the the number close to the bottom right
 	ebitenutil.DebugPrintAt(screen, fmt.Sprintf("%d", v.amount), int(drawX+7), int(drawY+5))
@@ -0,0 +1,171 @@
+package hud
+
+import (
+	"image/color"
+
+	"github.com/N3moAhead/harvest/internal/component"
+	"github.com/N3moAhead/harvest/internal/config"
+	"github.com/N3moAhead/harvest/internal/drawstats"
+	"github.com/N3moAhead/harvest/internal/entity/item/itemtype"
+	"github.com/N3moAhead/harvest/pkg/ui"
+	"github.com/N3moAhead/harvest/pkg/util"
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/vector"
+)
+
+type MapMarkerKind int
+
+const (
+	CookStationMarker MapMarkerKind = iota
+	WeaponMarker
+)
+
+// A point of interest shown on the minimap. ItemType is
+// the soup of a cook station or the type of a weapon.
+type MapMarker struct {
+	Kind     MapMarkerKind
+	Pos      component.Vector2D
+	ItemType itemtype.ItemType
+}
+
+// Everything the minimap and the off-screen indicators show
+type MapSource interface {
+	PlayerPosition() component.Vector2D
+	EnemyPositions() []component.Vector2D
+	MapMarkers() []MapMarker
+}
+
+var (
+	minimapBackground  = color.RGBA{R: 0, G: 0, B: 0, A: 160}
+	minimapBorder      = color.RGBA{R: 255, G: 255, B: 255, A: 200}
+	cookStationColor   = color.RGBA{R: 180, G: 13, B: 27, A: 255}
+	weaponMarkerColor  = color.RGBA{R: 60, G: 90, B: 160, A: 255}
+	playerMarkerColor  = color.RGBA{R: 255, G: 255, B: 255, A: 255}
+	enemyDensityColor  = color.NRGBA{R: 220, G: 30, B: 30, A: 255} // The alpha depends on the amount of enemies
+	markerIconFallback = color.RGBA{R: 255, G: 255, B: 255, A: 255}
+)
+
+// The minimap sits in the bottom right corner and shows the area around
+// the player. Markers outside of the shown area stick to its border so
+// the player always knows in which direction they are.
+type Minimap struct {
+	ui.BaseElement
+	source MapSource
+	icons  iconCache
+}
+
+func NewMinimap(source MapSource) *Minimap {
+	size := float64(config.MINIMAP_SIZE)
+	return &Minimap{
+		BaseElement: *ui.NewBaseElement(
+			config.SCREEN_WIDTH-size-config.MINIMAP_MARGIN,
+			config.SCREEN_HEIGHT-size-config.MINIMAP_MARGIN,
+			size,
+			size,
+		),
+		source: source,
+		icons:  make(iconCache),
+	}
+}
+
+func (m *Minimap) Draw(screen *ebiten.Image) {
+	if !m.Visible {
+		return
+	}
+	vector.DrawFilledRect(screen, float32(m.X), float32(m.Y), float32(m.Width), float32(m.Height), minimapBackground, false)
+
+	center := m.source.PlayerPosition()
+	m.drawEnemyDensity(screen, center)
+	for _, marker := range m.source.MapMarkers() {
+		x, y := m.toMinimap(marker.Pos, center)
+		// Keep the whole marker inside of the minimap
+		half := config.MINIMAP_ICON_SIZE / 2.0
+		x = util.Clamp(x, m.X+half, m.X+m.Width-half)
+		y = util.Clamp(y, m.Y+half, m.Y+m.Height-half)
+		m.drawMarker(screen, marker, x, y)
+	}
+
+	// The player is always in the center
+	vector.DrawFilledCircle(screen, float32(m.X+m.Width/2), float32(m.Y+m.Height/2), 3, playerMarkerColor, true)
+	vector.StrokeRect(screen, float32(m.X), float32(m.Y), float32(m.Width), float32(m.Height), 1, minimapBorder, false)
+
+	m.BaseElement.Draw(screen)
+}
+
+// Converts a position in the world to a position on the minimap
+func (m *Minimap) toMinimap(pos, center component.Vector2D) (float64, float64) {
+	scale := m.Width / (2 * config.MINIMAP_RANGE)
+	return m.X + m.Width/2 + (pos.X-center.X)*scale, m.Y + m.Height/2 + (pos.Y-center.Y)*scale
+}
+
+// Counts the enemies per cell and tints the cells. The more enemies
+// are in a cell the stronger it gets colored.
+func (m *Minimap) drawEnemyDensity(screen *ebiten.Image, center component.Vector2D) {
+	var counts [config.MINIMAP_DENSITY_CELLS][config.MINIMAP_DENSITY_CELLS]int
+	cellSize := m.Width / config.MINIMAP_DENSITY_CELLS
+	for _, pos := range m.source.EnemyPositions() {
+		x, y := m.toMinimap(pos, center)
+		cellX := int((x - m.X) / cellSize)
+		cellY := int((y - m.Y) / cellSize)
+		if x < m.X || y < m.Y || cellX >= config.MINIMAP_DENSITY_CELLS || cellY >= config.MINIMAP_DENSITY_CELLS {
+			continue
+		}
+		counts[cellY][cellX]++
+	}
+
+	for cellY := range counts {
+		for cellX, count := range counts[cellY] {
+			if count == 0 {
+				continue
+			}
+			density := min(1, float64(count)/config.MINIMAP_DENSITY_MAX)
+			clr := enemyDensityColor
+			clr.A = uint8(40 + 170*density)
+			vector.DrawFilledRect(
+				screen,
+				float32(m.X+float64(cellX)*cellSize),
+				float32(m.Y+float64(cellY)*cellSize),
+				float32(cellSize),
+				float32(cellSize),
+				clr,
+				false,
+			)
+		}
+	}
+}
+
+func (m *Minimap) drawMarker(screen *ebiten.Image, marker MapMarker, x, y float64) {
+	clr := weaponMarkerColor
+	if marker.Kind == CookStationMarker {
+		clr = cookStationColor
+	}
+	vector.DrawFilledCircle(screen, float32(x), float32(y), config.MINIMAP_ICON_SIZE/2+1, clr, true)
+	drawIcon(screen, m.icons.get(marker.ItemType), x, y, config.MINIMAP_ICON_SIZE)
+}
+
+// The icons of the markers. Icons are looked up once and reused afterwards.
+type iconCache map[itemtype.ItemType]*ebiten.Image
+
+func (c iconCache) get(itemType itemtype.ItemType) *ebiten.Image {
+	icon, ok := c[itemType]
+	if !ok {
+		icon = getItemIcon(getItemInfo(itemType))
+		c[itemType] = icon
+	}
+	return icon
+}
+
+// Draws the icon centered on x, y and scaled to the given size
+func drawIcon(screen *ebiten.Image, icon *ebiten.Image, x, y, size float64) {
+	if icon == nil {
+		vector.DrawFilledCircle(screen, float32(x), float32(y), float32(size/4), markerIconFallback, true)
+		return
+	}
+	scale := size / float64(icon.Bounds().Dx())
+	op := &ebiten.DrawImageOptions{}
+	op.GeoM.Scale(scale, scale)
+	op.GeoM.Translate(x-size/2, y-size/2)
+	drawstats.DrawImage(screen, icon, op)
+}
+
+var _ ui.UIElement = (*Minimap)(nil)
@@ -0,0 +1,123 @@
+package hud
+
+import (
+	"image"
+	"image/color"
+	"math"
+
+	"github.com/N3moAhead/harvest/internal/camera"
+	"github.com/N3moAhead/harvest/internal/component"
+	"github.com/N3moAhead/harvest/internal/config"
+	"github.com/N3moAhead/harvest/internal/display"
+	"github.com/N3moAhead/harvest/pkg/ui"
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/vector"
+)
+
+var indicatorArrowColor = color.RGBA{R: 255, G: 220, B: 120, A: 230}
+
+// A white pixel used as the texture of the arrows. It is cut out of a
+// bigger image so the borders do not bleed into the triangles.
+var whitePixel = func() *ebiten.Image {
+	img := ebiten.NewImage(3, 3)
+	img.Fill(color.White)
+	return img.SubImage(image.Rect(1, 1, 2, 2)).(*ebiten.Image)
+}()
+
+// OffscreenIndicators draws arrows at the border of the screen that
+// point to cook stations which are close to the player but not visible.
+type OffscreenIndicators struct {
+	ui.BaseElement
+	source MapSource
+	cam    *camera.Camera
+	icons  iconCache
+}
+
+// The indicators cover the whole screen. Add them with
+// UIManager.AddFullscreenElement so they follow the resolution.
+func NewOffscreenIndicators(source MapSource, cam *camera.Camera) *OffscreenIndicators {
+	screenWidth, screenHeight := display.Size()
+	return &OffscreenIndicators{
+		BaseElement: *ui.NewBaseElement(0, 0, float64(screenWidth), float64(screenHeight)),
+		source:      source,
+		cam:         cam,
+		icons:       make(iconCache),
+	}
+}
+
+func (o *OffscreenIndicators) Draw(screen *ebiten.Image) {
+	if !o.Visible {
+		return
+	}
+	view := o.cam.ViewRect()
+	playerPos := o.source.PlayerPosition()
+	for _, marker := range o.source.MapMarkers() {
+		if marker.Kind != CookStationMarker || view.Contains(marker.Pos) {
+			continue
+		}
+		if marker.Pos.Sub(playerPos).Len() > config.OFFSCREEN_INDICATOR_RANGE {
+			continue
+		}
+		o.drawIndicator(screen, marker)
+	}
+	o.BaseElement.Draw(screen)
+}
+
+// Places the arrow where the line from the center of the screen
+// to the marker leaves the screen
+func (o *OffscreenIndicators) drawIndicator(screen *ebiten.Image, marker MapMarker) {
+	center := component.NewVector2D(o.X+o.Width/2, o.Y+o.Height/2)
+	dir := o.cam.WorldToScreen(marker.Pos).Sub(center).Normalize()
+	if dir.LengthSq() == 0 {
+		return
+	}
+
+	margin := float64(config.OFFSCREEN_INDICATOR_MARGIN)
+	halfWidth := o.Width/2 - margin
+	halfHeight := o.Height/2 - margin
+	// Scale the direction until it hits the first border
+	scale := math.Inf(1)
+	if dir.X != 0 {
+		scale = math.Min(scale, halfWidth/math.Abs(dir.X))
+	}
+	if dir.Y != 0 {
+		scale = math.Min(scale, halfHeight/math.Abs(dir.Y))
+	}
+	tip := center.Add(dir.Mul(scale))
+
+	drawArrow(screen, tip, dir, config.OFFSCREEN_INDICATOR_SIZE)
+	// The soup of the station is shown right behind the arrow
+	iconPos := tip.Sub(dir.Mul(config.OFFSCREEN_INDICATOR_SIZE * 1.8))
+	vector.DrawFilledCircle(screen, float32(iconPos.X), float32(iconPos.Y), config.MINIMAP_ICON_SIZE/2+3, cookStationColor, true)
+	drawIcon(screen, o.icons.get(marker.ItemType), iconPos.X, iconPos.Y, config.MINIMAP_ICON_SIZE+2)
+}
+
+// Draws a triangle with its tip at tip that points into dir
+func drawArrow(screen *ebiten.Image, tip, dir component.Vector2D, size float64) {
+	back := tip.Sub(dir.Mul(size))
+	side := component.NewVector2D(-dir.Y, dir.X).Mul(size / 2)
+	left := back.Add(side)
+	right := back.Sub(side)
+
+	var path vector.Path
+	path.MoveTo(float32(tip.X), float32(tip.Y))
+	path.LineTo(float32(left.X), float32(left.Y))
+	path.LineTo(float32(right.X), float32(right.Y))
+	path.Close()
+
+	vertices, indices := path.AppendVerticesAndIndicesForFilling(nil, nil)
+	r, g, b, a := indicatorArrowColor.RGBA()
+	for i := range vertices {
+		vertices[i].SrcX = 1
+		vertices[i].SrcY = 1
+		vertices[i].ColorR = float32(r) / 0xffff
+		vertices[i].ColorG = float32(g) / 0xffff
+		vertices[i].ColorB = float32(b) / 0xffff
+		vertices[i].ColorA = float32(a) / 0xffff
+	}
+	op := &ebiten.DrawTrianglesOptions{}
+	op.AntiAlias = true
+	screen.DrawTriangles(vertices, indices, whitePixel, op)
+}
+
+var _ ui.UIElement = (*OffscreenIndicators)(nil)
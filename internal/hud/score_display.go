@@ -46,7 +46,8 @@ func (sd *ScoreDisplay) Draw(screen *ebiten.Image) {
 		drawText := fmt.Sprintf("%s: %d", sd.ScoreText, *sd.ScorePointer)
 		bounds := text.BoundString(sd.Font, drawText)
 		textY := sd.Y + float64(sd.Font.Metrics().Ascent/64)
-		textX := config.SCREEN_WIDTH - (bounds.Dx() + 10)
+		// The score grows to the left so the text ends at the right edge
+		textX := int(sd.X+sd.Width) - (bounds.Dx() + 10)
 		text.Draw(screen, drawText, sd.Font, textX, int(textY), sd.Color)
 	}
 	sd.BaseElement.Draw(screen)
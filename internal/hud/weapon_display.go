@@ -22,9 +22,6 @@ func NewWeaponDisplay(x, y float64, invRef *inventory.Inventory) *WeaponDisplay
 		inv:       invRef,
 	}
 
-	newDisplay.Width = config.MAX_WEAPONS * config.ITEM_FRAME_SIZE
-	newDisplay.Height = config.ITEM_FRAME_SIZE
-
 	for range config.MAX_WEAPONS {
 		// The position for the frame does not matter because the
 		// container will set it for us
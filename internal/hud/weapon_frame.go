@@ -5,6 +5,7 @@ import (
 
 	"github.com/N3moAhead/harvest/internal/assets"
 	"github.com/N3moAhead/harvest/internal/config"
+	"github.com/N3moAhead/harvest/internal/drawstats"
 	"github.com/N3moAhead/harvest/internal/entity/item/itemtype"
 	"github.com/N3moAhead/harvest/internal/entity/player/inventory"
 	"github.com/N3moAhead/harvest/pkg/ui"
@@ -61,7 +62,7 @@ func (v *WeaponFrame) Draw(screen *ebiten.Image) {
 	// Drawing the frame
 	op := &ebiten.DrawImageOptions{}
 	op.GeoM.Translate(v.X, v.Y)
-	screen.DrawImage(v.ItemFrameImg, op)
+	drawstats.DrawImage(screen, v.ItemFrameImg, op)
 	if v.itemType != itemtype.Undefined {
 		v.drawItemDisplay(screen)
 		if v.isHovered {
@@ -80,7 +81,7 @@ func (v *WeaponFrame) drawItemDisplay(screen *ebiten.Image) {
 	drawY := v.Y + offsetY
 	op := &ebiten.DrawImageOptions{}
 	op.GeoM.Translate(drawX, drawY)
-	screen.DrawImage(itemIcon, op)
+	drawstats.DrawImage(screen, itemIcon, op)
 	// TODO replace the debug print with a real font!!
 	// Adding some padding here to move the the number close to the bottom right
 	ebitenutil.DebugPrintAt(screen, fmt.Sprintf("lvl. %d", v.level), int(v.X+4), int(drawY+8))
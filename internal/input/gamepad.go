@@ -0,0 +1,158 @@
+package input
+
+import (
+	"fmt"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/inpututil"
+)
+
+// GamepadButton is a button of a gamepad with the standard layout.
+// The buttons are named like the buttons of an Xbox controller.
+type GamepadButton ebiten.StandardGamepadButton
+
+var gamepadButtonNames = map[GamepadButton]string{
+	GamepadButton(ebiten.StandardGamepadButtonRightBottom):      "A",
+	GamepadButton(ebiten.StandardGamepadButtonRightRight):       "B",
+	GamepadButton(ebiten.StandardGamepadButtonRightLeft):        "X",
+	GamepadButton(ebiten.StandardGamepadButtonRightTop):         "Y",
+	GamepadButton(ebiten.StandardGamepadButtonFrontTopLeft):     "LB",
+	GamepadButton(ebiten.StandardGamepadButtonFrontTopRight):    "RB",
+	GamepadButton(ebiten.StandardGamepadButtonFrontBottomLeft):  "LT",
+	GamepadButton(ebiten.StandardGamepadButtonFrontBottomRight): "RT",
+	GamepadButton(ebiten.StandardGamepadButtonCenterLeft):       "Back",
+	GamepadButton(ebiten.StandardGamepadButtonCenterRight):      "Start",
+	GamepadButton(ebiten.StandardGamepadButtonCenterCenter):     "Home",
+	GamepadButton(ebiten.StandardGamepadButtonLeftStick):        "LS",
+	GamepadButton(ebiten.StandardGamepadButtonRightStick):       "RS",
+	GamepadButton(ebiten.StandardGamepadButtonLeftTop):          "DPadUp",
+	GamepadButton(ebiten.StandardGamepadButtonLeftRight):        "DPadRight",
+	GamepadButton(ebiten.StandardGamepadButtonLeftBottom):       "DPadDown",
+	GamepadButton(ebiten.StandardGamepadButtonLeftLeft):         "DPadLeft",
+}
+
+func (b GamepadButton) String() string {
+	if name, ok := gamepadButtonNames[b]; ok {
+		return name
+	}
+	return fmt.Sprintf("Button%d", int(b))
+}
+
+// The buttons are stored by their name so the settings file stays readable
+func (b GamepadButton) MarshalText() ([]byte, error) {
+	if _, ok := gamepadButtonNames[b]; !ok {
+		return nil, fmt.Errorf("input: unknown gamepad button %d", int(b))
+	}
+	return []byte(b.String()), nil
+}
+
+func (b *GamepadButton) UnmarshalText(text []byte) error {
+	for button, name := range gamepadButtonNames {
+		if name == string(text) {
+			*b = button
+			return nil
+		}
+	}
+	return fmt.Errorf("input: unknown gamepad button %q", string(text))
+}
+
+// GamepadBinds maps every action to the gamepad button that triggers it
+type GamepadBinds map[Action]GamepadButton
+
+func DefaultGamepadBinds() GamepadBinds {
+	return GamepadBinds{
+		ActionUp:            GamepadButton(ebiten.StandardGamepadButtonLeftTop),
+		ActionRight:         GamepadButton(ebiten.StandardGamepadButtonLeftRight),
+		ActionDown:          GamepadButton(ebiten.StandardGamepadButtonLeftBottom),
+		ActionLeft:          GamepadButton(ebiten.StandardGamepadButtonLeftLeft),
+		ActionConfirm:       GamepadButton(ebiten.StandardGamepadButtonRightBottom),
+		ActionCancel:        GamepadButton(ebiten.StandardGamepadButtonRightRight),
+		ActionInteract:      GamepadButton(ebiten.StandardGamepadButtonRightLeft),
+		ActionPause:         GamepadButton(ebiten.StandardGamepadButtonCenterRight),
+		ActionToggleMinimap: GamepadButton(ebiten.StandardGamepadButtonCenterLeft),
+	}
+}
+
+// Clone returns a copy that can be changed without changing the original
+func (g GamepadBinds) Clone() GamepadBinds {
+	clone := make(GamepadBinds, len(g))
+	for action, button := range g {
+		clone[action] = button
+	}
+	return clone
+}
+
+// The gamepad binds used by the actions
+var gamepadBinds = DefaultGamepadBinds()
+
+// SetGamepadBinds replaces the gamepad buttons of the actions. Actions
+// that are missing in the given binds keep their default button.
+func SetGamepadBinds(newBinds GamepadBinds) {
+	gamepadBinds = DefaultGamepadBinds()
+	for action, button := range newBinds {
+		gamepadBinds[action] = button
+	}
+}
+
+// Reused every tick to avoid allocations
+var (
+	gamepadIDs     []ebiten.GamepadID
+	gamepadButtons []ebiten.StandardGamepadButton
+)
+
+// Returns the connected gamepads that have the standard layout.
+// Other gamepads are ignored because their buttons are unknown.
+func standardGamepads() []ebiten.GamepadID {
+	gamepadIDs = ebiten.AppendGamepadIDs(gamepadIDs[:0])
+	n := 0
+	for _, id := range gamepadIDs {
+		if ebiten.IsStandardGamepadLayoutAvailable(id) {
+			gamepadIDs[n] = id
+			n++
+		}
+	}
+	gamepadIDs = gamepadIDs[:n]
+	return gamepadIDs
+}
+
+func isGamepadButtonPressed(button GamepadButton) bool {
+	for _, id := range standardGamepads() {
+		if ebiten.IsStandardGamepadButtonPressed(id, ebiten.StandardGamepadButton(button)) {
+			return true
+		}
+	}
+	return false
+}
+
+func isGamepadButtonJustPressed(button GamepadButton) bool {
+	for _, id := range standardGamepads() {
+		if inpututil.IsStandardGamepadButtonJustPressed(id, ebiten.StandardGamepadButton(button)) {
+			return true
+		}
+	}
+	return false
+}
+
+// JustPressedGamepadButton returns a button that was pressed in this
+// tick on any gamepad. It is used to bind a button to an action.
+func JustPressedGamepadButton() (GamepadButton, bool) {
+	for _, id := range standardGamepads() {
+		gamepadButtons = inpututil.AppendJustPressedStandardGamepadButtons(id, gamepadButtons[:0])
+		if len(gamepadButtons) > 0 {
+			return GamepadButton(gamepadButtons[0]), true
+		}
+	}
+	return 0, false
+}
+
+// Returns the position of the left stick that is tilted the most
+func leftStick() (x, y float64) {
+	for _, id := range standardGamepads() {
+		stickX := ebiten.StandardGamepadAxisValue(id, ebiten.StandardGamepadAxisLeftStickHorizontal)
+		stickY := ebiten.StandardGamepadAxisValue(id, ebiten.StandardGamepadAxisLeftStickVertical)
+		if stickX*stickX+stickY*stickY > x*x+y*y {
+			x, y = stickX, stickY
+		}
+	}
+	return x, y
+}
@@ -1,30 +1,123 @@
 package input
 
 import (
+	"math"
+
+	"github.com/N3moAhead/harvest/internal/component"
+	"github.com/N3moAhead/harvest/internal/config"
+	"github.com/N3moAhead/harvest/internal/display"
 	"github.com/hajimehoshi/ebiten/v2"
 	"github.com/hajimehoshi/ebiten/v2/inpututil"
 )
 
 type InputState struct {
-	Up                     bool
-	Right                  bool
-	Down                   bool
-	Left                   bool
-	Esc                    bool
+	// The direction the player wants to move in. Its length is 1 for keys
+	// and between 0 and 1 for analog sticks that are only tilted a bit.
+	Move                   component.Vector2D
+	Pause                  bool
+	Confirm                bool
+	Cancel                 bool
+	Interact               bool
+	ToggleMinimap          bool
+	FocusNext              bool // Tab moves the focus of menus to the next element
+	FocusPrevious          bool // Shift+Tab moves the focus back
 	MouseX, MouseY         int
 	MouseButtonLeftPressed bool
+	MouseButtonLeftDown    bool
+	WheelY                 float64 // Positive when the mouse wheel is scrolled up
 }
 
 func GetInputState() *InputState {
-	mouseX, mouseY := ebiten.CursorPosition()
+	// The window can be larger than the game, the cursor is moved into the game
+	mouseX, mouseY := display.CursorPosition()
+	_, wheelY := ebiten.Wheel()
+	tab := inpututil.IsKeyJustPressed(ebiten.KeyTab)
+	shift := ebiten.IsKeyPressed(ebiten.KeyShift)
 	return &InputState{
-		Up:                     ebiten.IsKeyPressed(ebiten.KeyW) || ebiten.IsKeyPressed(ebiten.KeyUp),
-		Right:                  ebiten.IsKeyPressed(ebiten.KeyD) || ebiten.IsKeyPressed(ebiten.KeyRight),
-		Down:                   ebiten.IsKeyPressed(ebiten.KeyS) || ebiten.IsKeyPressed(ebiten.KeyDown),
-		Left:                   ebiten.IsKeyPressed(ebiten.KeyA) || ebiten.IsKeyPressed(ebiten.KeyLeft),
-		Esc:                    ebiten.IsKeyPressed(ebiten.KeyEscape),
+		Move:                   MoveVector(),
+		Pause:                  IsActionJustPressed(ActionPause),
+		Confirm:                IsActionJustPressed(ActionConfirm),
+		Cancel:                 IsActionJustPressed(ActionCancel),
+		Interact:               IsActionJustPressed(ActionInteract),
+		ToggleMinimap:          IsActionJustPressed(ActionToggleMinimap),
+		FocusNext:              tab && !shift,
+		FocusPrevious:          tab && shift,
 		MouseX:                 mouseX,
 		MouseY:                 mouseY,
 		MouseButtonLeftPressed: inpututil.IsMouseButtonJustPressed(ebiten.MouseButtonLeft),
+		MouseButtonLeftDown:    ebiten.IsMouseButtonPressed(ebiten.MouseButtonLeft),
+		WheelY:                 wheelY,
+	}
+}
+
+// IsActionPressed reports whether the key or the gamepad button of the action is held down
+func IsActionPressed(action Action) bool {
+	if key, ok := keybinds[action]; ok && ebiten.IsKeyPressed(key) {
+		return true
+	}
+	if button, ok := gamepadBinds[action]; ok && isGamepadButtonPressed(button) {
+		return true
+	}
+	return false
+}
+
+// IsActionJustPressed reports whether the key or the gamepad button of the action was pressed in this tick
+func IsActionJustPressed(action Action) bool {
+	if key, ok := keybinds[action]; ok && inpututil.IsKeyJustPressed(key) {
+		return true
+	}
+	if button, ok := gamepadBinds[action]; ok && isGamepadButtonJustPressed(button) {
+		return true
+	}
+	return false
+}
+
+// MoveVector returns the direction the player wants to move in.
+// Keys and the d-pad win over the analog stick.
+func MoveVector() component.Vector2D {
+	// The arrow keys can always be used to move
+	up := IsActionPressed(ActionUp) || ebiten.IsKeyPressed(ebiten.KeyUp)
+	right := IsActionPressed(ActionRight) || ebiten.IsKeyPressed(ebiten.KeyRight)
+	down := IsActionPressed(ActionDown) || ebiten.IsKeyPressed(ebiten.KeyDown)
+	left := IsActionPressed(ActionLeft) || ebiten.IsKeyPressed(ebiten.KeyLeft)
+	if move := DigitalVector(up, right, down, left); move.Len() > 0 {
+		return move
+	}
+	x, y := leftStick()
+	return StickVector(x, y, config.GAMEPAD_STICK_DEADZONE)
+}
+
+// DigitalVector converts pressed directions to a vector of length 1.
+// Opposite directions cancel each other out.
+func DigitalVector(up, right, down, left bool) component.Vector2D {
+	move := component.Vector2D{}
+	if up {
+		move.Y -= 1
+	}
+	if down {
+		move.Y += 1
+	}
+	if left {
+		move.X -= 1
+	}
+	if right {
+		move.X += 1
+	}
+	if move.X != 0 && move.Y != 0 {
+		move = move.Normalize()
+	}
+	return move
+}
+
+// StickVector converts the position of an analog stick to a move vector.
+// Positions inside of the dead zone are ignored because worn sticks
+// never rest exactly in the center. Outside of the dead zone the length
+// grows from 0 to 1 so small tilts move slowly.
+func StickVector(x, y, deadzone float64) component.Vector2D {
+	length := math.Hypot(x, y)
+	if length <= deadzone || deadzone >= 1 {
+		return component.Vector2D{}
 	}
+	scaled := math.Min(1, (length-deadzone)/(1-deadzone))
+	return component.NewVector2D(x/length*scaled, y/length*scaled)
 }
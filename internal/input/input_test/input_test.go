@@ -0,0 +1,120 @@
+package input_test
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+
+	"github.com/N3moAhead/harvest/internal/input"
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+const epsilon = 1e-9
+
+func TestDigitalVector(t *testing.T) {
+	testCases := []struct {
+		name                  string
+		up, right, down, left bool
+		wantX, wantY          float64
+	}{
+		{"None", false, false, false, false, 0, 0},
+		{"Up", true, false, false, false, 0, -1},
+		{"Right", false, true, false, false, 1, 0},
+		{"OppositeCancel", true, false, true, false, 0, 0},
+		{"Diagonal", false, true, true, false, math.Sqrt2 / 2, math.Sqrt2 / 2},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			move := input.DigitalVector(tc.up, tc.right, tc.down, tc.left)
+			if math.Abs(move.X-tc.wantX) > epsilon || math.Abs(move.Y-tc.wantY) > epsilon {
+				t.Errorf("Expected (%f, %f), got %v", tc.wantX, tc.wantY, move)
+			}
+		})
+	}
+}
+
+func TestStickVector(t *testing.T) {
+	const deadzone = 0.2
+
+	if move := input.StickVector(0.1, -0.1, deadzone); move.Len() != 0 {
+		t.Errorf("Expected positions inside of the dead zone to be ignored, got %v", move)
+	}
+
+	// Halfway between the dead zone and the edge moves with half the speed
+	move := input.StickVector(0.6, 0, deadzone)
+	if math.Abs(move.X-0.5) > epsilon || move.Y != 0 {
+		t.Errorf("Expected (0.5, 0), got %v", move)
+	}
+
+	// Sticks report values slightly above 1 in the corners
+	move = input.StickVector(1, 1, deadzone)
+	if math.Abs(move.Len()-1) > epsilon {
+		t.Errorf("Expected the length to be capped at 1, got %f", move.Len())
+	}
+	if math.Abs(move.X-move.Y) > epsilon {
+		t.Errorf("Expected the direction to be kept, got %v", move)
+	}
+}
+
+func TestGamepadButtonText(t *testing.T) {
+	for _, button := range input.DefaultGamepadBinds() {
+		text, err := button.MarshalText()
+		if err != nil {
+			t.Fatalf("Failed to marshal %d: %v", int(button), err)
+		}
+		var parsed input.GamepadButton
+		if err := parsed.UnmarshalText(text); err != nil {
+			t.Fatalf("Failed to unmarshal %s: %v", text, err)
+		}
+		if parsed != button {
+			t.Errorf("Expected %s, got %s", button, parsed)
+		}
+	}
+
+	var parsed input.GamepadButton
+	if err := parsed.UnmarshalText([]byte("Turbo")); err == nil {
+		t.Error("Expected an error for an unknown button")
+	}
+}
+
+func TestGamepadBindsJSON(t *testing.T) {
+	binds := input.DefaultGamepadBinds()
+	binds[input.ActionInteract] = input.GamepadButton(ebiten.StandardGamepadButtonRightTop)
+
+	data, err := json.Marshal(binds)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var parsed input.GamepadBinds
+	if err := json.Unmarshal(data, &parsed); err != nil {
+		t.Fatal(err)
+	}
+	if parsed[input.ActionInteract].String() != "Y" {
+		t.Errorf("Expected Interact on Y, got %s in %s", parsed[input.ActionInteract], data)
+	}
+	if len(parsed) != len(binds) {
+		t.Errorf("Expected %d binds, got %d", len(binds), len(parsed))
+	}
+}
+
+func TestEveryActionHasDefaultBinds(t *testing.T) {
+	keybinds := input.DefaultKeybinds()
+	gamepadBinds := input.DefaultGamepadBinds()
+	for _, action := range input.Actions {
+		if _, ok := keybinds[action]; !ok {
+			t.Errorf("The action %s has no default key", action)
+		}
+		if _, ok := gamepadBinds[action]; !ok {
+			t.Errorf("The action %s has no default gamepad button", action)
+		}
+	}
+}
+
+func TestCloneDoesNotShareTheMap(t *testing.T) {
+	binds := input.DefaultGamepadBinds()
+	clone := binds.Clone()
+	clone[input.ActionPause] = input.GamepadButton(ebiten.StandardGamepadButtonCenterCenter)
+	if binds[input.ActionPause] == clone[input.ActionPause] {
+		t.Error("Expected the clone to be independent")
+	}
+}
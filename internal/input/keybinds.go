@@ -0,0 +1,94 @@
+package input
+
+import "github.com/hajimehoshi/ebiten/v2"
+
+// An Action is something the player can do by pressing a key or a gamepad button
+type Action string
+
+const (
+	ActionUp            Action = "up"
+	ActionRight         Action = "right"
+	ActionDown          Action = "down"
+	ActionLeft          Action = "left"
+	ActionConfirm       Action = "confirm"
+	ActionCancel        Action = "cancel"
+	ActionInteract      Action = "interact"
+	ActionPause         Action = "pause"
+	ActionToggleMinimap Action = "toggle_minimap"
+)
+
+// All actions in the order they are shown in the settings
+var Actions = []Action{
+	ActionUp,
+	ActionRight,
+	ActionDown,
+	ActionLeft,
+	ActionConfirm,
+	ActionCancel,
+	ActionInteract,
+	ActionPause,
+	ActionToggleMinimap,
+}
+
+func (a Action) String() string {
+	switch a {
+	case ActionUp:
+		return "Move Up"
+	case ActionRight:
+		return "Move Right"
+	case ActionDown:
+		return "Move Down"
+	case ActionLeft:
+		return "Move Left"
+	case ActionConfirm:
+		return "Confirm"
+	case ActionCancel:
+		return "Cancel"
+	case ActionInteract:
+		return "Interact"
+	case ActionPause:
+		return "Pause"
+	case ActionToggleMinimap:
+		return "Minimap"
+	default:
+		return string(a)
+	}
+}
+
+// Keybinds maps every action to the key that triggers it
+type Keybinds map[Action]ebiten.Key
+
+func DefaultKeybinds() Keybinds {
+	return Keybinds{
+		ActionUp:            ebiten.KeyW,
+		ActionRight:         ebiten.KeyD,
+		ActionDown:          ebiten.KeyS,
+		ActionLeft:          ebiten.KeyA,
+		ActionConfirm:       ebiten.KeyEnter,
+		ActionCancel:        ebiten.KeyEscape,
+		ActionInteract:      ebiten.KeyE,
+		ActionPause:         ebiten.KeyEscape,
+		ActionToggleMinimap: ebiten.KeyM,
+	}
+}
+
+// Clone returns a copy that can be changed without changing the original
+func (k Keybinds) Clone() Keybinds {
+	clone := make(Keybinds, len(k))
+	for action, key := range k {
+		clone[action] = key
+	}
+	return clone
+}
+
+// The keybinds used by the actions
+var keybinds = DefaultKeybinds()
+
+// SetKeybinds replaces the keys of the actions. Actions that are missing
+// in the given keybinds keep their default key.
+func SetKeybinds(newKeybinds Keybinds) {
+	keybinds = DefaultKeybinds()
+	for action, key := range newKeybinds {
+		keybinds[action] = key
+	}
+}
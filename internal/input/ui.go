@@ -0,0 +1,27 @@
+package input
+
+import (
+	"github.com/N3moAhead/harvest/pkg/ui"
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+// UI converts the state into the input of the ui. Menus read the same
+// actions as the game so keyboards and gamepads work in them too.
+// Typed characters and edit keys are read for text inputs.
+func (s *InputState) UI() *ui.InputState {
+	return &ui.InputState{
+		MouseX:                 s.MouseX,
+		MouseY:                 s.MouseY,
+		MouseButtonLeftPressed: s.MouseButtonLeftPressed,
+		MouseButtonLeftDown:    s.MouseButtonLeftDown,
+		Confirm:                s.Confirm,
+		Cancel:                 s.Cancel,
+		MoveX:                  s.Move.X,
+		MoveY:                  s.Move.Y,
+		FocusNext:              s.FocusNext,
+		FocusPrevious:          s.FocusPrevious,
+		WheelY:                 s.WheelY,
+		Chars:                  ebiten.AppendInputChars(nil),
+		EditKeys:               ui.AppendEditKeys(nil),
+	}
+}
@@ -0,0 +1,145 @@
+package leaderboard
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"time"
+
+	"github.com/N3moAhead/harvest/internal/config"
+)
+
+// Run is a finished game as it is shown on the leaderboard
+type Run struct {
+	Score        int           `json:"score"`
+	SurvivalTime time.Duration `json:"survival_time"`
+	Wave         int           `json:"wave"`
+	Weapons      []string      `json:"weapons"` // The names of the weapons the run ended with
+	MapKind      string        `json:"map"`
+	Seed         int64         `json:"seed"` // The seed of the map. Arenas have no seed.
+	Date         time.Time     `json:"date"`
+}
+
+// Leaderboard keeps the best runs and the last runs that were played.
+// It is stored as json in the config directory of the user.
+type Leaderboard struct {
+	Best    []Run `json:"best"`    // The best runs sorted by score, the best first
+	History []Run `json:"history"` // The last runs, the newest first
+}
+
+func New() *Leaderboard {
+	return &Leaderboard{
+		Best:    []Run{},
+		History: []Run{},
+	}
+}
+
+// Path returns the location of the leaderboard file in the config directory
+func Path() string {
+	return config.FilePath(config.LEADERBOARD_FILE_NAME)
+}
+
+// Load reads the leaderboard from the file. A missing file is not an error,
+// an empty leaderboard is used instead. On errors it is empty too.
+func Load(path string) (*Leaderboard, error) {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return New(), nil
+	}
+	if err != nil {
+		return New(), fmt.Errorf("failed to read leaderboard %s: %w", path, err)
+	}
+	l := New()
+	if err := json.Unmarshal(data, l); err != nil {
+		return New(), fmt.Errorf("failed to parse leaderboard %s: %w", path, err)
+	}
+	// Hand edited files could be out of order or too long
+	sort.SliceStable(l.Best, func(i, j int) bool { return l.Best[i].Score > l.Best[j].Score })
+	l.Best = l.Best[:min(len(l.Best), config.LEADERBOARD_SIZE)]
+	l.History = l.History[:min(len(l.History), config.RUN_HISTORY_SIZE)]
+	return l, nil
+}
+
+// Save writes the leaderboard to the file and creates its directory if needed
+func (l *Leaderboard) Save(path string) error {
+	data, err := json.MarshalIndent(l, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("failed to create the leaderboard directory: %w", err)
+	}
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		return fmt.Errorf("failed to write leaderboard %s: %w", path, err)
+	}
+	return nil
+}
+
+// Add records a finished run. It returns the place of the run on the
+// leaderboard starting at 1, or 0 if the run was not good enough.
+// Runs with the same score as an older run are placed below it.
+func (l *Leaderboard) Add(run Run) (place int) {
+	l.History = slices.Insert(l.History, 0, run)
+	l.History = l.History[:min(len(l.History), config.RUN_HISTORY_SIZE)]
+
+	index := sort.Search(len(l.Best), func(i int) bool { return l.Best[i].Score < run.Score })
+	if index >= config.LEADERBOARD_SIZE {
+		return 0
+	}
+	l.Best = slices.Insert(l.Best, index, run)
+	l.Best = l.Best[:min(len(l.Best), config.LEADERBOARD_SIZE)]
+	return index + 1
+}
+
+// SortKey decides how runs are ordered
+type SortKey int
+
+const (
+	SortByDate SortKey = iota
+	SortByScore
+	SortBySurvivalTime
+	SortByWave
+)
+
+// The sort keys in the order they are cycled through
+var SortKeys = []SortKey{SortByDate, SortByScore, SortBySurvivalTime, SortByWave}
+
+func (k SortKey) String() string {
+	switch k {
+	case SortByDate:
+		return "Date"
+	case SortByScore:
+		return "Score"
+	case SortBySurvivalTime:
+		return "Time"
+	case SortByWave:
+		return "Wave"
+	default:
+		return "Unknown"
+	}
+}
+
+// SortRuns returns a sorted copy of the runs. The newest, highest or
+// longest runs come first. Runs that are equal keep their order.
+func SortRuns(runs []Run, key SortKey) []Run {
+	sorted := slices.Clone(runs)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		a, b := sorted[i], sorted[j]
+		switch key {
+		case SortByScore:
+			return a.Score > b.Score
+		case SortBySurvivalTime:
+			return a.SurvivalTime > b.SurvivalTime
+		case SortByWave:
+			return a.Wave > b.Wave
+		default:
+			return a.Date.After(b.Date)
+		}
+	})
+	return sorted
+}
@@ -0,0 +1,131 @@
+package leaderboard_test
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/N3moAhead/harvest/internal/config"
+	"github.com/N3moAhead/harvest/internal/leaderboard"
+)
+
+func TestAddReturnsThePlace(t *testing.T) {
+	board := leaderboard.New()
+	if place := board.Add(leaderboard.Run{Score: 100}); place != 1 {
+		t.Errorf("Expected the first run to be #1, got %d", place)
+	}
+	if place := board.Add(leaderboard.Run{Score: 300}); place != 1 {
+		t.Errorf("Expected the better run to be #1, got %d", place)
+	}
+	if place := board.Add(leaderboard.Run{Score: 200}); place != 2 {
+		t.Errorf("Expected the run to be #2, got %d", place)
+	}
+	for i, want := range []int{300, 200, 100} {
+		if board.Best[i].Score != want {
+			t.Errorf("Expected %d at #%d, got %d", want, i+1, board.Best[i].Score)
+		}
+	}
+}
+
+func TestAddPlacesTiesBelowOlderRuns(t *testing.T) {
+	board := leaderboard.New()
+	board.Add(leaderboard.Run{Score: 100, Seed: 1})
+	if place := board.Add(leaderboard.Run{Score: 100, Seed: 2}); place != 2 {
+		t.Errorf("Expected the tie to be #2, got %d", place)
+	}
+	if board.Best[0].Seed != 1 {
+		t.Errorf("Expected the older run to stay #1, got seed %d", board.Best[0].Seed)
+	}
+}
+
+func TestAddKeepsOnlyTheBestRuns(t *testing.T) {
+	board := leaderboard.New()
+	for i := range config.LEADERBOARD_SIZE {
+		board.Add(leaderboard.Run{Score: 100 + i})
+	}
+	if place := board.Add(leaderboard.Run{Score: 1}); place != 0 {
+		t.Errorf("Expected a bad run to not place, got #%d", place)
+	}
+	if place := board.Add(leaderboard.Run{Score: 1000}); place != 1 {
+		t.Errorf("Expected the best run to be #1, got #%d", place)
+	}
+	if len(board.Best) != config.LEADERBOARD_SIZE {
+		t.Errorf("Expected %d best runs, got %d", config.LEADERBOARD_SIZE, len(board.Best))
+	}
+	if last := board.Best[len(board.Best)-1].Score; last != 101 {
+		t.Errorf("Expected the worst run to drop out, the last run has %d", last)
+	}
+}
+
+func TestHistoryKeepsTheLastRuns(t *testing.T) {
+	board := leaderboard.New()
+	for i := range config.RUN_HISTORY_SIZE + 5 {
+		board.Add(leaderboard.Run{Score: i})
+	}
+	if len(board.History) != config.RUN_HISTORY_SIZE {
+		t.Fatalf("Expected %d runs in the history, got %d", config.RUN_HISTORY_SIZE, len(board.History))
+	}
+	if newest := board.History[0].Score; newest != config.RUN_HISTORY_SIZE+4 {
+		t.Errorf("Expected the newest run first, got %d", newest)
+	}
+}
+
+func TestSortRuns(t *testing.T) {
+	now := time.Now()
+	runs := []leaderboard.Run{
+		{Score: 10, Wave: 3, SurvivalTime: time.Minute, Date: now.Add(-time.Hour)},
+		{Score: 30, Wave: 1, SurvivalTime: 3 * time.Minute, Date: now.Add(-2 * time.Hour)},
+		{Score: 20, Wave: 2, SurvivalTime: 2 * time.Minute, Date: now},
+	}
+	tests := []struct {
+		key  leaderboard.SortKey
+		want []int // The scores in the expected order
+	}{
+		{leaderboard.SortByDate, []int{20, 10, 30}},
+		{leaderboard.SortByScore, []int{30, 20, 10}},
+		{leaderboard.SortBySurvivalTime, []int{30, 20, 10}},
+		{leaderboard.SortByWave, []int{10, 20, 30}},
+	}
+	for _, tt := range tests {
+		sorted := leaderboard.SortRuns(runs, tt.key)
+		for i, want := range tt.want {
+			if sorted[i].Score != want {
+				t.Errorf("%s: expected score %d at %d, got %d", tt.key, want, i, sorted[i].Score)
+			}
+		}
+	}
+	// The runs themselves are not sorted
+	if runs[0].Score != 10 {
+		t.Error("Expected SortRuns to leave the runs unchanged")
+	}
+}
+
+func TestSaveAndLoad(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "harvest", "leaderboard.json")
+	board := leaderboard.New()
+	board.Add(leaderboard.Run{Score: 50, Wave: 4, Weapons: []string{"Spoon"}, MapKind: "Arena", Seed: 7})
+	if err := board.Save(path); err != nil {
+		t.Fatalf("Save failed: %v", err)
+	}
+	loaded, err := leaderboard.Load(path)
+	if err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+	if len(loaded.Best) != 1 || len(loaded.History) != 1 {
+		t.Fatalf("Expected one run, got %d best and %d in the history", len(loaded.Best), len(loaded.History))
+	}
+	run := loaded.Best[0]
+	if run.Score != 50 || run.Wave != 4 || run.Seed != 7 || run.MapKind != "Arena" || len(run.Weapons) != 1 {
+		t.Errorf("The run was not restored: %+v", run)
+	}
+}
+
+func TestLoadMissingFile(t *testing.T) {
+	board, err := leaderboard.Load(filepath.Join(t.TempDir(), "missing.json"))
+	if err != nil {
+		t.Fatalf("Expected no error for a missing file, got %v", err)
+	}
+	if len(board.Best) != 0 || len(board.History) != 0 {
+		t.Error("Expected an empty leaderboard")
+	}
+}
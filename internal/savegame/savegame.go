@@ -0,0 +1,163 @@
+package savegame
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/N3moAhead/harvest/internal/config"
+	"github.com/N3moAhead/harvest/internal/entity/item/itemtype"
+)
+
+// Version is increased whenever the snapshot changes in a way older
+// games can not read anymore. Snapshots of other versions are rejected.
+const Version = 1
+
+var ErrIncompatibleVersion = errors.New("the run was saved by an incompatible version of the game")
+
+// Snapshot is everything needed to continue a run later on.
+// Timers are stored as the time that was left or that has passed
+// when the run got saved, so they continue where they stopped.
+// The timers of the run count the play time, pauses are not included.
+type Snapshot struct {
+	Version      int           `json:"version"`
+	SavedAt      time.Time     `json:"saved_at"`
+	MapKind      int           `json:"map_kind"`
+	Seed         int64         `json:"seed"` // The seed of the map. Arenas have no seed.
+	PlayerLevel  uint          `json:"player_level"`
+	Score        int           `json:"score"`
+	WaveIndex    int           `json:"wave_index"` // -1 before the first wave started
+	PlayTime     time.Duration `json:"play_time"`
+	SinceWave    time.Duration `json:"since_wave"`         // Since the current wave started
+	SinceStation time.Duration `json:"since_cook_station"` // Since the last cook station spawned
+	Random       Random        `json:"random"`
+	Player       Player        `json:"player"`
+	Inventory    Inventory     `json:"inventory"`
+	Enemies      []Enemy       `json:"enemies"`
+	Items        []Item        `json:"items"`
+	CookStations []CookStation `json:"cook_stations"`
+}
+
+// Random is the state of the random numbers of a run. Seeding a source
+// with the seed and skipping the draws continues the same numbers.
+type Random struct {
+	Seed  int64  `json:"seed"`
+	Draws uint64 `json:"draws"`
+}
+
+type Player struct {
+	X     float64 `json:"x"`
+	Y     float64 `json:"y"`
+	HP    float64 `json:"hp"`
+	MaxHP float64 `json:"max_hp"`
+	Soups []Soup  `json:"soups"`
+}
+
+type Soup struct {
+	Type      itemtype.ItemType `json:"type"`
+	Remaining time.Duration     `json:"remaining"`
+}
+
+type Inventory struct {
+	Vegetables map[itemtype.ItemType]int `json:"vegetables"`
+	Soups      map[itemtype.ItemType]int `json:"soups"`
+	Weapons    []Weapon                  `json:"weapons"`
+}
+
+type Weapon struct {
+	Slot  int               `json:"slot"`
+	Type  itemtype.ItemType `json:"type"`
+	Level int               `json:"level"`
+}
+
+type Enemy struct {
+	Type  string  `json:"type"` // The name the enemy is registered with in the spawner
+	X     float64 `json:"x"`
+	Y     float64 `json:"y"`
+	HP    float64 `json:"hp"`
+	MaxHP float64 `json:"max_hp"`
+	Speed float64 `json:"speed"`
+	Scale float64 `json:"scale"`
+	Elite bool    `json:"elite"`
+}
+
+type Item struct {
+	Type itemtype.ItemType `json:"type"`
+	X    float64           `json:"x"`
+	Y    float64           `json:"y"`
+}
+
+type CookStation struct {
+	X          float64           `json:"x"`
+	Y          float64           `json:"y"`
+	Soup       itemtype.ItemType `json:"soup"` // The soup of the recipe
+	CostFactor float64           `json:"cost_factor"`
+}
+
+// Path returns the location of the saved run in the config directory
+func Path() string {
+	return config.FilePath(config.SAVE_FILE_NAME)
+}
+
+// Exists reports whether there is a saved run at the path
+func Exists(path string) bool {
+	_, err := os.Stat(path)
+	return err == nil
+}
+
+// Load reads a saved run. Snapshots of other versions return
+// ErrIncompatibleVersion without reading the rest of the file.
+func Load(path string) (*Snapshot, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the saved run %s: %w", path, err)
+	}
+	return Decode(data)
+}
+
+// Decode reads a snapshot from its json form
+func Decode(data []byte) (*Snapshot, error) {
+	// The version is read first because the rest may have a different layout
+	var header struct {
+		Version int `json:"version"`
+	}
+	if err := json.Unmarshal(data, &header); err != nil {
+		return nil, fmt.Errorf("failed to parse the saved run: %w", err)
+	}
+	if header.Version != Version {
+		return nil, fmt.Errorf("%w: got version %d, want %d", ErrIncompatibleVersion, header.Version, Version)
+	}
+	s := &Snapshot{}
+	if err := json.Unmarshal(data, s); err != nil {
+		return nil, fmt.Errorf("failed to parse the saved run: %w", err)
+	}
+	return s, nil
+}
+
+// Save writes the snapshot to the file and creates its directory if needed
+func (s *Snapshot) Save(path string) error {
+	s.Version = Version
+	data, err := json.Marshal(s)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("failed to create the save directory: %w", err)
+	}
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		return fmt.Errorf("failed to write the saved run %s: %w", path, err)
+	}
+	return nil
+}
+
+// Delete removes the saved run. A missing file is not an error.
+func Delete(path string) error {
+	if err := os.Remove(path); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("failed to delete the saved run %s: %w", path, err)
+	}
+	return nil
+}
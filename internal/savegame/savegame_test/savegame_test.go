@@ -0,0 +1,107 @@
+package savegame_test
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/N3moAhead/harvest/internal/entity/item/itemtype"
+	"github.com/N3moAhead/harvest/internal/savegame"
+)
+
+func TestSaveAndLoad(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "harvest", "run.json")
+	snapshot := &savegame.Snapshot{
+		Seed:      42,
+		Score:     1234,
+		WaveIndex: 3,
+		PlayTime:  90 * time.Second,
+		Random:    savegame.Random{Seed: 7, Draws: 99},
+		Player: savegame.Player{
+			X: 10, Y: -20, HP: 55, MaxHP: 100,
+			Soups: []savegame.Soup{{Type: itemtype.SpeedSoup, Remaining: 3 * time.Second}},
+		},
+		Inventory: savegame.Inventory{
+			Vegetables: map[itemtype.ItemType]int{itemtype.Carrot: 12},
+			Weapons:    []savegame.Weapon{{Slot: 1, Type: itemtype.Spoon, Level: 3}},
+		},
+		Enemies: []savegame.Enemy{{Type: "carrot", X: 1, Y: 2, HP: 5, MaxHP: 10, Speed: 1.5, Scale: 1.2, Elite: true}},
+	}
+	if err := snapshot.Save(path); err != nil {
+		t.Fatalf("Save failed: %v", err)
+	}
+	if !savegame.Exists(path) {
+		t.Fatal("Expected the saved run to exist")
+	}
+
+	loaded, err := savegame.Load(path)
+	if err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+	if loaded.Version != savegame.Version {
+		t.Errorf("Expected version %d, got %d", savegame.Version, loaded.Version)
+	}
+	if loaded.Seed != 42 || loaded.Score != 1234 || loaded.WaveIndex != 3 || loaded.PlayTime != 90*time.Second {
+		t.Errorf("The run was not restored: %+v", loaded)
+	}
+	if loaded.Random != snapshot.Random {
+		t.Errorf("Expected random state %+v, got %+v", snapshot.Random, loaded.Random)
+	}
+	if loaded.Inventory.Vegetables[itemtype.Carrot] != 12 {
+		t.Errorf("Expected 12 carrots, got %d", loaded.Inventory.Vegetables[itemtype.Carrot])
+	}
+	if len(loaded.Inventory.Weapons) != 1 || loaded.Inventory.Weapons[0] != snapshot.Inventory.Weapons[0] {
+		t.Errorf("Expected weapons %+v, got %+v", snapshot.Inventory.Weapons, loaded.Inventory.Weapons)
+	}
+	if len(loaded.Enemies) != 1 || loaded.Enemies[0] != snapshot.Enemies[0] {
+		t.Errorf("Expected enemies %+v, got %+v", snapshot.Enemies, loaded.Enemies)
+	}
+	if len(loaded.Player.Soups) != 1 || loaded.Player.Soups[0] != snapshot.Player.Soups[0] {
+		t.Errorf("Expected soups %+v, got %+v", snapshot.Player.Soups, loaded.Player.Soups)
+	}
+}
+
+func TestDecodeRejectsOtherVersions(t *testing.T) {
+	for _, data := range []string{`{"version": 0}`, `{"version": 999, "score": "a different layout"}`} {
+		_, err := savegame.Decode([]byte(data))
+		if !errors.Is(err, savegame.ErrIncompatibleVersion) {
+			t.Errorf("Expected ErrIncompatibleVersion for %s, got %v", data, err)
+		}
+	}
+}
+
+func TestDecodeRejectsBrokenFiles(t *testing.T) {
+	_, err := savegame.Decode([]byte(`{"version": `))
+	if err == nil || errors.Is(err, savegame.ErrIncompatibleVersion) {
+		t.Errorf("Expected a parse error, got %v", err)
+	}
+}
+
+func TestLoadMissingFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "missing.json")
+	if _, err := savegame.Load(path); !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("Expected a not exist error, got %v", err)
+	}
+	if savegame.Exists(path) {
+		t.Error("Expected a missing file to not exist")
+	}
+}
+
+func TestDelete(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "run.json")
+	if err := (&savegame.Snapshot{}).Save(path); err != nil {
+		t.Fatalf("Save failed: %v", err)
+	}
+	if err := savegame.Delete(path); err != nil {
+		t.Fatalf("Delete failed: %v", err)
+	}
+	if savegame.Exists(path) {
+		t.Error("Expected the saved run to be deleted")
+	}
+	// Deleting again is not an error
+	if err := savegame.Delete(path); err != nil {
+		t.Errorf("Expected no error for a missing file, got %v", err)
+	}
+}
@@ -0,0 +1,81 @@
+package scene
+
+import (
+	"image/color"
+
+	"github.com/N3moAhead/harvest/internal/assets"
+	"github.com/N3moAhead/harvest/internal/input"
+	"github.com/N3moAhead/harvest/pkg/ui"
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+// The confirm overlay asks before something that can not be undone happens.
+// Both answers remove the overlay again.
+type ConfirmScene struct {
+	BaseScene
+	uiManager *ui.UIManager
+	onConfirm func()
+}
+
+func NewConfirmScene(question string, onConfirm func()) *ConfirmScene {
+	fontFace, ok := assets.AssetStore.GetFont("2p")
+	if !ok {
+		panic("Unable to load font in confirm scene")
+	}
+	microFont, ok := assets.AssetStore.GetFont("micro")
+	if !ok {
+		panic("Unable to load font in confirm scene")
+	}
+
+	newUiManager := newUIManager()
+	newConfirmScene := &ConfirmScene{
+		BaseScene: *NewBaseScene(),
+		uiManager: newUiManager,
+		onConfirm: onConfirm,
+	}
+
+	title := ui.NewLabel(0, 0, "Are you sure?", fontFace, color.White)
+	newUiManager.AddAnchoredElement(title, ui.AnchorCenter, 0, -70)
+	questionLabel := ui.NewLabel(0, 0, question, microFont, color.White)
+	newUiManager.AddAnchoredElement(questionLabel, ui.AnchorCenter, 0, -25)
+
+	// No comes first so it is focused when the dialog is controlled with keys
+	buttons := ui.NewContainer(0, 0, &ui.ContainerOptions{
+		Direction: ui.Row,
+		Gap:       20,
+	})
+	buttons.AddChild(ui.NewButton(0, 0, 140, 50, "No", fontFace, newConfirmScene.cancel))
+	buttons.AddChild(ui.NewButton(0, 0, 140, 50, "Yes", fontFace, newConfirmScene.confirm))
+	newUiManager.AddAnchoredElement(buttons, ui.AnchorCenter, 0, 40)
+
+	return newConfirmScene
+}
+
+func (c *ConfirmScene) confirm() {
+	c.SetIsRunning(false)
+	c.onConfirm()
+}
+
+// The scene manager removes the overlay once it stopped running
+func (c *ConfirmScene) cancel() {
+	c.SetIsRunning(false)
+}
+
+func (c *ConfirmScene) Update() error {
+	updateUI(c.uiManager)
+	if input.IsActionJustPressed(input.ActionPause) || input.IsActionJustPressed(input.ActionCancel) {
+		c.cancel()
+	}
+	return nil
+}
+
+func (c *ConfirmScene) Draw(screen *ebiten.Image) {
+	drawOverlayBackground(screen)
+	c.uiManager.Draw(screen)
+}
+
+func (c *ConfirmScene) IsOverlay() bool {
+	return true
+}
+
+var _ Overlay = (*ConfirmScene)(nil)
@@ -0,0 +1,120 @@
+package gamescene
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/N3moAhead/harvest/internal/component"
+	"github.com/N3moAhead/harvest/internal/config"
+	"github.com/N3moAhead/harvest/internal/cooking"
+	"github.com/N3moAhead/harvest/internal/entity/item"
+	"github.com/N3moAhead/harvest/internal/world"
+)
+
+// MapKind decides which kind of map a game is played on
+type MapKind int
+
+const (
+	ProceduralMap MapKind = iota // A new generated map for every run
+	ArenaMap                     // The handcrafted arena made with tiled
+)
+
+func (m MapKind) String() string {
+	switch m {
+	case ProceduralMap:
+		return "Procedural"
+	case ArenaMap:
+		return "Arena"
+	default:
+		return "Unknown"
+	}
+}
+
+// The names used for weapons in the object layers of arena maps
+var arenaWeapons = map[string]func(x, y float64) *item.Item{
+	"spoon":           item.NewSpoon,
+	"throwing_knifes": item.NewThrowingKnifes,
+	"rolling_pin":     item.NewRollingPin,
+	"thermalmixer":    item.NewThermalmixer,
+}
+
+// Creates the world for the map kind. If the arena can not be
+// loaded the game falls back to a procedural map. Procedural maps use
+// the seed to be recreated, a seed of 0 generates a new map.
+func newGameWorld(mapKind MapKind, seed int64) *world.World {
+	if mapKind == ArenaMap {
+		arenaWorld, err := world.NewWorldFromTiled(config.ARENA_MAP_PATH)
+		if err == nil {
+			return arenaWorld
+		}
+		fmt.Println("Warning: Could not load the arena, falling back to a procedural map:", err)
+	}
+	// Every run gets its own map. The seed is kept to be able to recreate it.
+	if seed == 0 {
+		seed = time.Now().UnixNano()
+	}
+	return world.NewWorld(seed)
+}
+
+func initArenaItems(arena *world.Arena) []*item.Item {
+	items := make([]*item.Item, 0, len(arena.Weapons))
+	for _, spot := range arena.Weapons {
+		create, ok := arenaWeapons[strings.ToLower(spot.Name)]
+		if !ok {
+			fmt.Printf("Warning: Unknown weapon '%s' in the arena\n", spot.Name)
+			continue
+		}
+		items = append(items, create(spot.Pos.X, spot.Pos.Y))
+	}
+	return items
+}
+
+// Returns a random position inside of one of the enemy spawn zones of the arena.
+// ok is false if the world has no spawn zones.
+func getArenaEnemySpawnPosition(g *GameScene) (pos component.Vector2D, ok bool) {
+	arena, ok := g.World.GetArena()
+	if !ok || len(arena.EnemySpawnZones) == 0 {
+		return component.Vector2D{}, false
+	}
+	zone := arena.EnemySpawnZones[g.rng.Intn(len(arena.EnemySpawnZones))]
+	return component.NewVector2D(zone.X+g.rng.Float64()*zone.Width, zone.Y+g.rng.Float64()*zone.Height), true
+}
+
+// Places a cook station on a random free cook station spot of the arena.
+// If every spot is taken no station gets placed.
+func spawnArenaCookStation(g *GameScene, arena *world.Arena) {
+	var freeSpots []world.ArenaSpot
+	for _, spot := range arena.CookStations {
+		taken := false
+		for _, station := range g.cookStations {
+			if !station.Used && station.Pos.Sub(spot.Pos).Len() < config.TILE_SIZE {
+				taken = true
+				break
+			}
+		}
+		if !taken {
+			freeSpots = append(freeSpots, spot)
+		}
+	}
+	if len(freeSpots) == 0 {
+		return
+	}
+
+	spot := freeSpots[g.rng.Intn(len(freeSpots))]
+	recipe := cooking.GetRandomRecipe()
+	if spot.Name != "" {
+		found := false
+		for soupType, definition := range cooking.RecipeDefinitions {
+			if strings.EqualFold(soupType.String(), spot.Name) {
+				recipe = definition
+				found = true
+				break
+			}
+		}
+		if !found {
+			fmt.Printf("Warning: Unknown soup '%s' for a cook station in the arena\n", spot.Name)
+		}
+	}
+	g.cookStations = append(g.cookStations, cooking.NewCookStation(spot.Pos.X, spot.Pos.Y, recipe, 1.0))
+}
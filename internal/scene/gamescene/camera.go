@@ -0,0 +1,49 @@
+package gamescene
+
+import (
+	"github.com/N3moAhead/harvest/internal/camera"
+	"github.com/N3moAhead/harvest/internal/config"
+	"github.com/N3moAhead/harvest/internal/display"
+	"github.com/N3moAhead/harvest/internal/entity/player"
+	"github.com/N3moAhead/harvest/internal/input"
+	"github.com/N3moAhead/harvest/internal/settings"
+	"github.com/N3moAhead/harvest/internal/world"
+)
+
+// Creates a camera that already looks at the player.
+// Maps with a fixed size keep the camera inside of them.
+func newGameCamera(gameWorld *world.World, p *player.Player) *camera.Camera {
+	cam := camera.NewCamera(display.Size())
+	if bounds, ok := gameWorld.Bounds(); ok {
+		cam.SetBounds(bounds)
+	}
+	cam.Follow(p.Pos, p.FacingDirection)
+	cam.Snap()
+	return cam
+}
+
+// Moves the camera to the player right away. Used after the player got placed.
+func snapCamera(g *GameScene) {
+	g.Camera.Follow(g.Player.Pos, g.Player.FacingDirection)
+	g.Camera.Snap()
+}
+
+func updateCamera(g *GameScene, inputState *input.InputState, dt float64) {
+	if inputState.WheelY != 0 {
+		g.Camera.SetZoom(g.Camera.Zoom() + inputState.WheelY*config.CAMERA_ZOOM_STEP)
+	}
+	// The visible area changes with the resolution
+	g.Camera.SetScreenSize(display.Size())
+	g.Camera.Follow(g.Player.Pos, g.Player.FacingDirection)
+	g.Camera.Update(dt)
+}
+
+// Shakes the screen when the player gets hit. Slams shake it harder.
+// The player can weaken or turn off the shake in the settings.
+func (g *GameScene) shakeOnHit(amount float64, heavy bool) {
+	trauma := config.CAMERA_SHAKE_ON_HIT
+	if heavy {
+		trauma = config.CAMERA_SHAKE_ON_SLAM
+	}
+	g.Camera.Shake(trauma * settings.Current().ShakeIntensity)
+}
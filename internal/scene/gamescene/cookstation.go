@@ -1,21 +1,20 @@
 package gamescene
 
 import (
-	"time"
-
+	"github.com/N3moAhead/harvest/internal/component"
 	"github.com/N3moAhead/harvest/internal/cooking"
 	"github.com/N3moAhead/harvest/internal/entity/player/inventory"
 	"github.com/N3moAhead/harvest/pkg/util"
 )
 
 func updateCookStations(g *GameScene, dt float64, inv *inventory.Inventory, elapsed float32) {
-	elapsedSec := float64(time.Since(g.startTime).Seconds())
+	elapsedSec := g.playTime.Seconds()
 	// start at 15 seconds, decrease to 5 seconds after 30 minutes /1800 seconds
 	rawInterval := 15.0 - (elapsedSec / 180.0)
 	interval := util.Clamp(rawInterval, 3.0, 15.0)
 
-	if time.Since(g.lastCookStationSpawnTime).Seconds() >= interval {
-		g.lastCookStationSpawnTime = time.Now()
+	if (g.playTime - g.lastCookStationSpawnTime).Seconds() >= interval {
+		g.lastCookStationSpawnTime = g.playTime
 		spawnCookBatch(g, 1) // Spawn a single cook station every interval
 	}
 	for _, cs := range g.cookStations {
@@ -24,11 +23,22 @@ func updateCookStations(g *GameScene, dt float64, inv *inventory.Inventory, elap
 	}
 }
 func spawnCookBatch(g *GameScene, count int) {
+	// Arenas have fixed positions for their cook stations
+	if arena, ok := g.World.GetArena(); ok && len(arena.CookStations) > 0 {
+		for range count {
+			spawnArenaCookStation(g, arena)
+		}
+		return
+	}
 	for i := 0; i < count; i++ {
-		cameraX, cameraY := g.World.GetCameraPosition()
-		spawnX, spawnY := util.GetRandomPositionInView(cameraX, cameraY) // Get a random position in the view
+		// Get a random position in the view
+		view := g.Camera.ViewRect()
+		spawnX := view.X + g.rng.Float64()*view.Width
+		spawnY := view.Y + g.rng.Float64()*view.Height
+		// Make sure the station is reachable and not placed in water or inside of a fence
+		spawnPos := g.World.FindWalkablePosition(component.NewVector2D(spawnX, spawnY))
 		recipe := cooking.GetRandomRecipe()
-		station := cooking.NewCookStation(spawnX, spawnY, recipe, 1.0) // TODO 1.0 is the cost factor, can be adjusted by elapsed time
+		station := cooking.NewCookStation(spawnPos.X, spawnPos.Y, recipe, 1.0) // TODO 1.0 is the cost factor, can be adjusted by elapsed time
 		g.cookStations = append(g.cookStations, station)
 	}
 }
@@ -74,9 +74,9 @@ func (g *GameScene) initializeWaves() {
 
 func updateEnemies(g *GameScene, dt float64, elapsed float32) {
 	if g.currentWaveIndex < totalWaves-1 {
-		if g.currentWaveIndex == -1 || time.Since(g.lastWaveStartTime).Seconds() >= waveIntervalSeconds {
+		if g.currentWaveIndex == -1 || (g.playTime-g.lastWaveStartTime).Seconds() >= waveIntervalSeconds {
 			g.currentWaveIndex++
-			g.lastWaveStartTime = time.Now()
+			g.lastWaveStartTime = g.playTime
 			spawnWaveEnemies(g)
 			font, ok := assets.AssetStore.GetFont("2p")
 			if ok {
@@ -93,13 +93,16 @@ func updateEnemies(g *GameScene, dt float64, elapsed float32) {
 	for i := len(g.Enemies) - 1; i >= 0; i-- {
 		e := g.Enemies[i]
 		wasAlive := e.IsAlive()
-		e.Update(g.Player, dt)
+		e.Update(g.Player, g.World, dt)
 
 		if wasAlive && !e.IsAlive() {
 			elapsedMinutes := float64(elapsed) / 60000.0
-			drops := e.TryDrop(float32(elapsedMinutes))
+			drops := e.TryDrop(g.rng, float32(elapsedMinutes))
 			// TODO each enemy should increase the score by a diffrent amount
 			g.Score += 10
+			if e.IsElite() {
+				g.Score += config.ELITE_SCORE
+			}
 			for j := range drops {
 				g.items = append(g.items, &drops[j])
 			}
@@ -155,13 +158,21 @@ func spawnEndlessMode(g *GameScene) {
 		countPerType = 1
 	}
 
+	firstNewEnemy := len(g.Enemies)
 	if countPerType > 200 {
 		for _, enemyTypeEnum := range waveDef.EnemyTypes {
 			enemyTypeStr := enemyTypeEnum.String()
-			g.Enemies = append(g.Enemies, g.Spawner.SpawnCircle(enemyTypeStr, g.Player, 300+rand.Float64()*100, countPerType)...)
+			g.Enemies = append(g.Enemies, g.Spawner.SpawnCircle(enemyTypeStr, g.Player, 300+g.rng.Float64()*100, countPerType)...)
 		}
 	} else if countPerType > 0 {
-		g.Enemies = append(g.Enemies, g.Spawner.SpawnCircle(enemy.TypeCarrot.String(), g.Player, 300+rand.Float64()*100, countPerType)...)
+		g.Enemies = append(g.Enemies, g.Spawner.SpawnCircle(enemy.TypeCarrot.String(), g.Player, 300+g.rng.Float64()*100, countPerType)...)
+	}
+
+	// In endless mode every new enemy has a small chance to be an elite
+	for _, e := range g.Enemies[firstNewEnemy:] {
+		if g.rng.Float64() < config.ELITE_ENDLESS_CHANCE {
+			e.MakeElite()
+		}
 	}
 }
 
@@ -181,6 +192,8 @@ func spawnWaveEnemies(g *GameScene) {
 		countPerType = 1
 	}
 
+	firstNewEnemy := len(g.Enemies)
+
 	for _, enemyTypeEnum := range waveDef.EnemyTypes {
 		enemyTypeStr := enemyTypeEnum.String()
 
@@ -191,16 +204,24 @@ func spawnWaveEnemies(g *GameScene) {
 			continue
 		}
 
-		spawnPatternChoice := rand.Intn(4)
+		spawnPatternChoice := g.rng.Intn(4)
 
 		if g.currentWaveIndex < 3 {
 			spawnPatternChoice = 0
 		}
 
+		// Arenas with spawn zones only spawn enemies inside of them
+		if _, hasZones := getArenaEnemySpawnPosition(g); hasZones {
+			spawnPatternChoice = 0
+		}
+
 		switch spawnPatternChoice {
 		case 0: // Spawn Random Outside of View
 			for i := 0; i < countPerType; i++ {
-				spawnPos := getOffscreenSpawnPosition(g.Player.Pos, config.SCREEN_WIDTH, config.SCREEN_HEIGHT, 100.0)
+				spawnPos, ok := getArenaEnemySpawnPosition(g)
+				if !ok {
+					spawnPos = getOffscreenSpawnPosition(g.rng, g.Camera.ViewRect(), 100.0)
+				}
 				newEnemy := g.Spawner.Spawn(enemyTypeStr, spawnPos)
 				if newEnemy != nil {
 					g.Enemies = append(g.Enemies, newEnemy)
@@ -208,7 +229,7 @@ func spawnWaveEnemies(g *GameScene) {
 			}
 		case 1: // Spawn Circle
 			if countPerType > 0 {
-				g.Enemies = append(g.Enemies, g.Spawner.SpawnCircle(enemyTypeStr, g.Player, 500+rand.Float64()*100, countPerType)...)
+				g.Enemies = append(g.Enemies, g.Spawner.SpawnCircle(enemyTypeStr, g.Player, 500+g.rng.Float64()*100, countPerType)...)
 			}
 		case 2: // Spawn ZigZag
 			if countPerType > 0 {
@@ -222,9 +243,9 @@ func spawnWaveEnemies(g *GameScene) {
 						break
 					}
 
-					startPos := component.NewVector2D(g.Player.Pos.X+float64(rand.Intn(1500)-750), g.Player.Pos.Y+float64(rand.Intn(1500)-750))
+					startPos := component.NewVector2D(g.Player.Pos.X+float64(g.rng.Intn(1500)-750), g.Player.Pos.Y+float64(g.rng.Intn(1500)-750))
 
-					g.Enemies = append(g.Enemies, g.Spawner.SpawnZigZag(enemyTypeStr, startPos, numToSpawnThisFormation, 30+rand.Float64()*20, 15+rand.Float64()*10)...)
+					g.Enemies = append(g.Enemies, g.Spawner.SpawnZigZag(enemyTypeStr, startPos, numToSpawnThisFormation, 30+g.rng.Float64()*20, 15+g.rng.Float64()*10)...)
 					enemiesSpawned += numToSpawnThisFormation
 				}
 			}
@@ -240,49 +261,64 @@ func spawnWaveEnemies(g *GameScene) {
 						break
 					}
 
-					startPos := component.NewVector2D(g.Player.Pos.X+float64(rand.Intn(1500)-750), g.Player.Pos.Y+float64(rand.Intn(1500)-750))
+					startPos := component.NewVector2D(g.Player.Pos.X+float64(g.rng.Intn(1500)-750), g.Player.Pos.Y+float64(g.rng.Intn(1500)-750))
 
-					g.Enemies = append(g.Enemies, g.Spawner.SpawnLine(enemyTypeStr, startPos, numToSpawnThisFormation, 25+rand.Float64()*15, 5+rand.Float64()*5)...)
+					g.Enemies = append(g.Enemies, g.Spawner.SpawnLine(enemyTypeStr, startPos, numToSpawnThisFormation, 25+g.rng.Float64()*15, 5+g.rng.Float64()*5)...)
 					enemiesSpawned += numToSpawnThisFormation
 				}
 			}
 		}
 	}
+
+	// A few enemies of each wave are elites that drop a chest
+	promoteElites(g.rng, g.Enemies[firstNewEnemy:], config.ELITES_PER_WAVE)
+}
+
+// Turns up to amount random enemies of the given enemies into elites
+func promoteElites(rng *rand.Rand, enemies []enemy.EnemyInterface, amount int) {
+	if len(enemies) == 0 {
+		return
+	}
+	for _, i := range rng.Perm(len(enemies))[:min(amount, len(enemies))] {
+		enemies[i].MakeElite()
+	}
 }
 
-// Helper function to get a spawn position just off-screen
-func getOffscreenSpawnPosition(playerPos component.Vector2D, screenWidth, screenHeight, buffer float64) component.Vector2D {
-	screenLeftEdge := playerPos.X - screenWidth/2
-	screenRightEdge := playerPos.X + screenWidth/2
-	screenTopEdge := playerPos.Y - screenHeight/2
-	screenBottomEdge := playerPos.Y + screenHeight/2
+// Helper function to get a spawn position just outside of the visible area
+func getOffscreenSpawnPosition(rng *rand.Rand, view component.Rect, buffer float64) component.Vector2D {
+	screenWidth := view.Width
+	screenHeight := view.Height
+	screenLeftEdge := view.X
+	screenRightEdge := view.X + view.Width
+	screenTopEdge := view.Y
+	screenBottomEdge := view.Y + view.Height
 
-	side := rand.Intn(4)
+	side := rng.Intn(4)
 	var x, y float64
 
-	randomizedOffset := buffer + (rand.Float64() * buffer)
+	randomizedOffset := buffer + (rng.Float64() * buffer)
 
 	switch side {
 	case 0: // Top
-		x = screenLeftEdge + rand.Float64()*screenWidth
+		x = screenLeftEdge + rng.Float64()*screenWidth
 		y = screenTopEdge - randomizedOffset
 	case 1: // Bottom
-		x = screenLeftEdge + rand.Float64()*screenWidth
+		x = screenLeftEdge + rng.Float64()*screenWidth
 		y = screenBottomEdge + randomizedOffset
 	case 2: // Left
 		x = screenLeftEdge - randomizedOffset
-		y = screenTopEdge + rand.Float64()*screenHeight
+		y = screenTopEdge + rng.Float64()*screenHeight
 	default: // Right
 		x = screenRightEdge + randomizedOffset
-		y = screenTopEdge + rand.Float64()*screenHeight
+		y = screenTopEdge + rng.Float64()*screenHeight
 	}
 	return component.NewVector2D(x, y)
 }
 
-func drawEnemies(g *GameScene, screen *ebiten.Image, mapOffsetX, mapOffsetY float64) {
+func drawEnemies(g *GameScene, screen *ebiten.Image) {
 	for _, e := range g.Enemies {
 		if e.IsAlive() {
-			e.Draw(screen, mapOffsetX, mapOffsetY)
+			e.Draw(screen, g.Camera)
 		}
 	}
 }
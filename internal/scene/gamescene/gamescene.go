@@ -1,25 +1,26 @@
 package gamescene
 
 import (
-	"bytes"
-	"fmt"
 	"math/rand"
 	"time"
 
 	"github.com/N3moAhead/harvest/internal/assets"
-	"github.com/N3moAhead/harvest/internal/config"
+	"github.com/N3moAhead/harvest/internal/camera"
+	"github.com/N3moAhead/harvest/internal/chest"
+	"github.com/N3moAhead/harvest/internal/component"
 	"github.com/N3moAhead/harvest/internal/cooking"
+	"github.com/N3moAhead/harvest/internal/display"
 	"github.com/N3moAhead/harvest/internal/entity/enemy"
 	"github.com/N3moAhead/harvest/internal/entity/item"
 	"github.com/N3moAhead/harvest/internal/entity/item/itemtype"
 	"github.com/N3moAhead/harvest/internal/entity/player"
 	"github.com/N3moAhead/harvest/internal/entity/player/inventory"
+	"github.com/N3moAhead/harvest/internal/hud"
 	"github.com/N3moAhead/harvest/internal/input"
 	"github.com/N3moAhead/harvest/internal/toast"
 	"github.com/N3moAhead/harvest/internal/world"
 	"github.com/N3moAhead/harvest/pkg/ui"
 	"github.com/hajimehoshi/ebiten/v2"
-	"github.com/hajimehoshi/ebiten/v2/audio"
 )
 
 type Score interface {
@@ -30,64 +31,80 @@ type GameScene struct {
 	lastSpawnTime            time.Time // last spawn batches
 	waveDefinitions          []WaveDefinition
 	currentWaveIndex         int
-	lastWaveStartTime        time.Time
+	lastWaveStartTime        time.Duration // The play time the current wave started at
 	gameStartTime            time.Time
 	Player                   *player.Player
 	World                    *world.World
+	Camera                   *camera.Camera
 	Enemies                  []enemy.EnemyInterface
 	Spawner                  *world.EnemySpawner
 	items                    []*item.Item
 	inventory                *inventory.Inventory
 	hud                      *ui.UIManager
-	gameOverlay              *ui.UIManager
+	minimap                  *hud.Minimap
 	isRunning                bool
-	isPaused                 bool
+	openPause                func() // Shows the pause overlay on top of the game
 	cookStations             []*cooking.CookStation
-	startTime                time.Time // game start
-	lastEnemySpawnTime       time.Time // last spawn batches
-	lastCookStationSpawnTime time.Time
+	playTime                 time.Duration // How long the run was played without the pauses
+	mapKind                  MapKind
+	playerLevel              uint
+	rng                      *rand.Rand // All random numbers of the run that are saved with it
+	rngSource                *countingSource
+	lastEnemySpawnTime       time.Time       // last spawn batches
+	lastCookStationSpawnTime time.Duration   // The play time the last cook station spawned at
+	chestReveals             []*chest.Reveal // Opened chests waiting for their reveal animation
 	Score                    int
 }
 
-func NewGameScene(backToMenu func(), playerLevel uint) *GameScene {
+// NewGameScene starts a new run. A seed of 0 plays on a new map,
+// other seeds play on the same map again.
+func NewGameScene(openPause func(), playerLevel uint, mapKind MapKind, seed int64) *GameScene {
+	gameWorld := newGameWorld(mapKind, seed)
+	// Procedural maps use their seed for the random numbers of the run too,
+	// so a run that is started again with the same seed plays the same way
+	newGameScene := newGameScene(openPause, playerLevel, mapKind, gameWorld, newCountingSource(gameWorld.GetSeed(), 0))
+	if arena, ok := gameWorld.GetArena(); ok {
+		newGameScene.Player.Pos = arena.PlayerSpawn
+		snapCamera(newGameScene)
+	}
+	newGameScene.items = initItems(gameWorld, newGameScene.rng)
+	newGameScene.initializeWaves()
+
+	assets.PlayMusic("game")
+
+	return newGameScene
+}
+
+// Creates a game scene without items and waves. They are added
+// by a new run or restored from a saved run.
+func newGameScene(openPause func(), playerLevel uint, mapKind MapKind, gameWorld *world.World, rngSource *countingSource) *GameScene {
+	newPlayer := player.NewPlayer(playerLevel)
 	newGameScene := &GameScene{
-		Player:             player.NewPlayer(playerLevel),
-		World:              world.NewWorld(config.WIDTH_IN_TILES, config.HEIGHT_IN_TILES),
+		Player:             newPlayer,
+		World:              gameWorld,
+		Camera:             newGameCamera(gameWorld, newPlayer),
 		Enemies:            []enemy.EnemyInterface{},
 		Spawner:            initEnemySpawner(),
 		inventory:          inventory.NewInventory(),
-		items:              initItems(),
+		items:              []*item.Item{},
 		hud:                nil,
 		isRunning:          true,
 		cookStations:       []*cooking.CookStation{},
-		startTime:          time.Now(),
 		lastEnemySpawnTime: time.Now(),
 		Score:              0,
+		openPause:          openPause,
+		mapKind:            mapKind,
+		playerLevel:        playerLevel,
+		rng:                rand.New(rngSource),
+		rngSource:          rngSource,
 	}
-	newGameScene.initializeWaves()
+	newPlayer.OnDamage = newGameScene.shakeOnHit
 	newGameScene.hud = initHUD(newGameScene)
-	newGameScene.gameOverlay = initGameOverlay(newGameScene, backToMenu)
-
-	/// Init Game Music
-	game_music, ok := assets.AssetStore.GetMusicData("game")
-	if ok {
-		musicBytesReader := bytes.NewReader(game_music)
-		loop := audio.NewInfiniteLoop(musicBytesReader, int64(len(game_music)))
-		if assets.MusicPlayer != nil {
-			assets.MusicPlayer.Close()
-			assets.MusicPlayer, _ = assets.AudioContext.NewPlayer(loop)
-		}
-		assets.MusicPlayer, _ = assets.AudioContext.NewPlayer(loop)
-		assets.MusicPlayer.Play()
-	} else {
-		fmt.Println("Warning: could not load game music")
-	}
-
 	return newGameScene
 }
 
 func (g *GameScene) Layout(outsideWidth, outsideHeight int) (screenWidth, screenHeight int) {
-	return config.SCREEN_WIDTH, config.SCREEN_HEIGHT
+	return display.Size()
 }
 
 func (g *GameScene) IsRunning() bool {
@@ -107,26 +124,36 @@ func (g *GameScene) Update() error {
 	inputState := input.GetInputState()
 
 	/// --- UI Update ---
-	// The ui is always getting updated everything else can be paused.
-	updateUI(g)
+	// Anchored elements move when a different resolution got chosen
+	screenWidth, screenHeight := display.Size()
+	g.hud.SetScreenSize(float64(screenWidth), float64(screenHeight))
+	g.hud.UpdateWithInput(inputState.UI())
+
+	// Pause on Escape or Start. The game is not updated while the overlay is shown.
+	if inputState.Pause {
+		g.openPause()
+		return nil
+	}
 
-	// Pause on Escape
-	if inputState.Esc {
-		g.isPaused = true
+	if inputState.ToggleMinimap {
+		g.minimap.SetVisible(!g.minimap.IsVisible())
 	}
 
-	// If the game is paused stop the update right here
-	if g.isPaused {
+	// The game also stands still while a chest is getting opened
+	if revealRunning := updateChestReveals(g); revealRunning {
 		return nil
 	}
 
 	// --- Time Update ---
 	dt := 1.0 / float64(ebiten.TPS())
 	dtDuration := time.Second / time.Duration(ebiten.TPS())
-	elapsed := float32(time.Since(g.startTime).Milliseconds())
+	g.playTime += dtDuration
+	// Waves, drops and cook stations only count the time that was played
+	elapsed := float32(g.playTime.Milliseconds())
 
 	/// --- Update Player ---
-	g.Player.Update(inputState, dt, g.inventory)
+	g.Player.Update(inputState, dt, g.inventory, g.World)
+	g.World.UpdateFlowField(g.Player.Pos)
 
 	/// --- Update Items on the Ground ---
 	updateItems(g)
@@ -134,19 +161,22 @@ func (g *GameScene) Update() error {
 	/// --- Update the Weapons ---
 	for _, weapon := range g.inventory.Weapons {
 		if weapon != nil {
-			weapon.Update(g.Player, g.Enemies, dtDuration)
+			weapon.Update(g.Player, g.Enemies, g.World, dtDuration)
 		}
 	}
 
 	// TODO remove this code for production!
 	// But keep it until cooking stations can spawn autonomisly
 	if ebiten.IsKeyPressed(ebiten.KeyC) {
+		screenWidth, screenHeight := display.Size()
 		for range 3 {
-			posX := rand.Float64() * config.WIDTH_IN_TILES * config.TILE_SIZE
-			posY := rand.Float64() * config.HEIGHT_IN_TILES * config.TILE_SIZE
+			pos := g.World.FindWalkablePosition(g.Player.Pos.Add(component.NewVector2D(
+				(g.rng.Float64()-0.5)*float64(screenWidth),
+				(g.rng.Float64()-0.5)*float64(screenHeight),
+			)))
 			g.cookStations = append(g.cookStations, cooking.NewCookStation(
-				posX,
-				posY,
+				pos.X,
+				pos.Y,
 				// cooking.RecipeDefinitions[itemtype.MagnetRadiusSoup],
 				cooking.RecipeDefinitions[itemtype.SpeedSoup],
 				1.0, // cost factor?
@@ -155,12 +185,11 @@ func (g *GameScene) Update() error {
 	}
 
 	/// --- Update World ---
-	g.World.Update(
-		g.Player.Pos,
-		config.SCREEN_WIDTH,
-		config.SCREEN_HEIGHT,
-		dt,
-	)
+	g.World.Update(g.Player.Pos)
+
+	/// --- Update Camera ---
+	updateCamera(g, inputState, dt)
+	cullFarEntities(g)
 
 	/// --- Toast ---
 	toast.UpdateToasts()
@@ -173,14 +202,8 @@ func (g *GameScene) Update() error {
 
 	/// --- Check if player died ---
 	if !g.Player.Alive() {
-		deathSound, ok := assets.AssetStore.GetSFXData("player_death_sound")
-		if ok {
-			sfxPlayer := assets.AudioContext.NewPlayerFromBytes(deathSound)
-			sfxPlayer.Play()
-		}
-		if assets.MusicPlayer.IsPlaying() {
-			assets.MusicPlayer.Pause()
-		}
+		assets.PlaySound("player_death_sound")
+		assets.Audio.StopMusic()
 		g.SetIsRunning(false)
 	}
 
@@ -189,29 +212,27 @@ func (g *GameScene) Update() error {
 
 func (g *GameScene) Draw(screen *ebiten.Image) {
 	/// --- Drawing the Map ---
-	g.World.Draw(screen)
-
-	mapOffsetX, mapOffsetY := g.World.GetCameraPosition()
+	g.World.Draw(screen, g.Camera)
 
 	/// --- Drawing all Items ---
-	drawItems(g, screen, mapOffsetX, mapOffsetY)
+	drawItems(g, screen)
 
 	/// --- Drawing the Player ---
-	g.Player.Draw(screen, mapOffsetX, mapOffsetY)
+	g.Player.Draw(screen, g.Camera)
 
 	/// --- Drawing the Enemies ---
-	drawEnemies(g, screen, mapOffsetX, mapOffsetY)
+	drawEnemies(g, screen)
 
 	/// --- Drawing Weapon Effects ---
 	for _, w := range g.inventory.Weapons {
 		if w != nil {
-			w.Draw(screen, g.Player, mapOffsetX, mapOffsetY)
+			w.Draw(screen, g.Player, g.Camera)
 		}
 	}
 
 	/// --- Drawing Cooking Stations ---
 	for _, cookStation := range g.cookStations {
-		cookStation.Draw(screen, mapOffsetX, mapOffsetY)
+		cookStation.Draw(screen, g.Camera)
 	}
 
 	/// --- Toasts ---
@@ -219,4 +240,7 @@ func (g *GameScene) Draw(screen *ebiten.Image) {
 
 	/// --- Drawing HUD ---
 	drawUI(g, screen)
+
+	/// --- Chest Reveal ---
+	drawChestReveal(g, screen)
 }
@@ -4,11 +4,14 @@ import (
 	"fmt"
 	"math/rand"
 
+	"github.com/N3moAhead/harvest/internal/chest"
 	"github.com/N3moAhead/harvest/internal/config"
 	"github.com/N3moAhead/harvest/internal/entity/item"
 	"github.com/N3moAhead/harvest/internal/entity/item/itemtype"
+	"github.com/N3moAhead/harvest/internal/soups"
 	"github.com/N3moAhead/harvest/internal/toast"
 	"github.com/N3moAhead/harvest/internal/weapon"
+	"github.com/N3moAhead/harvest/internal/world"
 	"github.com/hajimehoshi/ebiten/v2"
 )
 
@@ -31,42 +34,21 @@ func updateItems(g *GameScene) {
 				toast.AddToast(fmt.Sprintf("%s collected! +10.000 Score", soup.Type.String()))
 				g.Player.ExtendOrAddSoup(soup)
 			case itemtype.CategoryWeapon:
-				switch gItem.Type {
-				case itemtype.Spoon:
-					newWeapon := weapon.NewSpoon()
-					added := g.inventory.AddWeapon(newWeapon)
-					if !added {
-						fmt.Printf("Inventory is full or weapon '%s' already exists\n", newWeapon.Name())
-					} else {
-						fmt.Printf("Weapon '%s' added to Inventory\n", newWeapon.Name())
-					}
-				case itemtype.ThrowingKnifes:
-					newWeapon := weapon.NewThrowingKnife()
-					added := g.inventory.AddWeapon(newWeapon)
-					if !added {
-						fmt.Printf("Inventory is full or weapon '%s' already exists\n", newWeapon.Name())
-					} else {
-						fmt.Printf("Weapon '%s' added to Inventory\n", newWeapon.Name())
-					}
-				case itemtype.RollingPin:
-					newWeapon := weapon.NewRollingPin()
-					added := g.inventory.AddWeapon(newWeapon)
-					if !added {
-						fmt.Printf("Inventory is full or weapon '%s' already exists\n", newWeapon.Name())
-					} else {
-						fmt.Printf("Weapon '%s' added to Inventory\n", newWeapon.Name())
-					}
-				case itemtype.Thermalmixer:
-					newWeapon := weapon.NewThermalmixer()
-					added := g.inventory.AddWeapon(newWeapon)
-					if !added {
-						fmt.Printf("Inventory is full or weapon '%s' already exists\n", newWeapon.Name())
-					} else {
-						fmt.Printf("Weapon '%s' added to Inventory\n", newWeapon.Name())
-					}
-				default:
+				newWeapon, ok := createWeapon(gItem.Type)
+				if !ok {
 					fmt.Printf("Warning: Unknown weapon type: %s", gItem.DisplayName())
+					break
 				}
+				added := g.inventory.AddWeapon(newWeapon)
+				if !added {
+					fmt.Printf("Inventory is full or weapon '%s' already exists\n", newWeapon.Name())
+				} else {
+					fmt.Printf("Weapon '%s' added to Inventory\n", newWeapon.Name())
+				}
+			case itemtype.CategoryChest:
+				// The rewards are granted after the reveal animation is over
+				rewards := chest.RollRewards(g.rng, g.inventory)
+				g.chestReveals = append(g.chestReveals, chest.NewReveal(rewards))
 			default:
 				panic(fmt.Errorf("unhandeld item category: %s in items update", gItem.CategoryOf().String()))
 			}
@@ -81,34 +63,101 @@ func updateItems(g *GameScene) {
 	g.items = g.items[:n]
 }
 
-func drawItems(g *GameScene, screen *ebiten.Image, mapOffsetX, mapOffsetY float64) {
+// Creates the weapon of a weapon item type. ok is false for other item types.
+func createWeapon(itemType itemtype.ItemType) (newWeapon weapon.Weapon, ok bool) {
+	switch itemType {
+	case itemtype.Spoon:
+		return weapon.NewSpoon(), true
+	case itemtype.ThrowingKnifes:
+		return weapon.NewThrowingKnife(), true
+	case itemtype.RollingPin:
+		return weapon.NewRollingPin(), true
+	case itemtype.Thermalmixer:
+		return weapon.NewThermalmixer(), true
+	default:
+		return nil, false
+	}
+}
+
+// Plays the reveal animation of the first opened chest.
+// Returns true as long as a chest reveal is running.
+func updateChestReveals(g *GameScene) (revealRunning bool) {
+	if len(g.chestReveals) == 0 {
+		return false
+	}
+	reveal := g.chestReveals[0]
+	if finished := reveal.Update(); finished {
+		applyChestRewards(g, reveal.Rewards)
+		g.chestReveals = g.chestReveals[1:]
+	}
+	return true
+}
+
+func applyChestRewards(g *GameScene, rewards []chest.Reward) {
+	for _, reward := range rewards {
+		switch reward.Kind {
+		case chest.RewardWeaponLevel:
+			for _, w := range g.inventory.Weapons {
+				if w != nil && w.GetType() == reward.ItemType {
+					if ok := w.LevelUp(); ok {
+						toast.AddToast(fmt.Sprintf("'%s' updated to level %d", w.Name(), w.Level()))
+					}
+					break
+				}
+			}
+		case chest.RewardSoup:
+			g.inventory.AddSoup(reward.ItemType)
+			g.Player.ExtendOrAddSoup(soups.Definitions[reward.ItemType])
+			toast.AddToast(fmt.Sprintf("%s collected!", reward.ItemType.String()))
+		case chest.RewardGold:
+			g.Score += config.CHEST_GOLD_SCORE
+			toast.AddToast(fmt.Sprintf("Gold found! +%d Score", config.CHEST_GOLD_SCORE))
+		default:
+			fmt.Printf("Warning: Unknown chest reward: %s\n", reward.Kind.String())
+		}
+	}
+}
+
+func drawChestReveal(g *GameScene, screen *ebiten.Image) {
+	if len(g.chestReveals) > 0 {
+		g.chestReveals[0].Draw(screen)
+	}
+}
+
+func drawItems(g *GameScene, screen *ebiten.Image) {
 	for _, item := range g.items {
-		item.Draw(screen, mapOffsetX, mapOffsetY)
+		item.Draw(screen, g.Camera)
 	}
 }
 
-func initItems() []*item.Item {
-	worldWidth := config.WIDTH_IN_TILES * config.TILE_SIZE
-	worldHeight := config.HEIGHT_IN_TILES * config.TILE_SIZE
+func initItems(gameWorld *world.World, rng *rand.Rand) []*item.Item {
+	// Arenas place their weapons by hand
+	if arena, ok := gameWorld.GetArena(); ok {
+		return initArenaItems(arena)
+	}
 	items := []*item.Item{
-		item.NewSpoon(
-			(config.WIDTH_IN_TILES*config.TILE_SIZE)/2,
-			(config.HEIGHT_IN_TILES*config.TILE_SIZE)/2-50,
-		),
+		item.NewSpoon(0, -50),
+	}
+	items = append(items, getWeaponsAtRandomPositions(rng, item.NewSpoon, 2)...)
+	items = append(items, getWeaponsAtRandomPositions(rng, item.NewThrowingKnifes, 3)...)
+	items = append(items, getWeaponsAtRandomPositions(rng, item.NewRollingPin, 3)...)
+	items = append(items, getWeaponsAtRandomPositions(rng, item.NewThermalmixer, 3)...)
+
+	// Weapons that landed on an obstacle would be unreachable for the player
+	for _, weaponItem := range items {
+		weaponItem.Pos = gameWorld.FindWalkablePosition(weaponItem.Pos)
 	}
-	items = append(items, getWeaponsAtRandomPositions(worldWidth, worldHeight, item.NewSpoon, 2)...)
-	items = append(items, getWeaponsAtRandomPositions(worldWidth, worldHeight, item.NewThrowingKnifes, 3)...)
-	items = append(items, getWeaponsAtRandomPositions(worldWidth, worldHeight, item.NewRollingPin, 3)...)
-	items = append(items, getWeaponsAtRandomPositions(worldWidth, worldHeight, item.NewThermalmixer, 3)...)
 
 	return items
 }
 
-func getWeaponsAtRandomPositions(worldWidth, worldHeight int, create func(x, y float64) *item.Item, amount int) []*item.Item {
+// The world is infinite so weapons are scattered in the area around the origin where the player spawns
+func getWeaponsAtRandomPositions(rng *rand.Rand, create func(x, y float64) *item.Item, amount int) []*item.Item {
 	var items []*item.Item = make([]*item.Item, 0)
+	spawnAreaSize := float64(config.SPAWN_AREA_IN_TILES * config.TILE_SIZE)
 	for range amount {
-		x := rand.Float64() * float64(worldWidth)
-		y := rand.Float64() * float64(worldHeight)
+		x := (rng.Float64() - 0.5) * spawnAreaSize
+		y := (rng.Float64() - 0.5) * spawnAreaSize
 		items = append(items, create(x, y))
 	}
 	return items
@@ -0,0 +1,42 @@
+package gamescene
+
+import (
+	"github.com/N3moAhead/harvest/internal/component"
+	"github.com/N3moAhead/harvest/internal/entity/item/itemtype"
+	"github.com/N3moAhead/harvest/internal/hud"
+)
+
+// The game scene provides the minimap with everything it shows
+
+func (g *GameScene) PlayerPosition() component.Vector2D {
+	return g.Player.Pos
+}
+
+func (g *GameScene) EnemyPositions() []component.Vector2D {
+	positions := make([]component.Vector2D, 0, len(g.Enemies))
+	for _, e := range g.Enemies {
+		if e.IsAlive() {
+			positions = append(positions, e.GetPosition())
+		}
+	}
+	return positions
+}
+
+// MapMarkers returns all unused cook stations and all weapons lying on the ground
+func (g *GameScene) MapMarkers() []hud.MapMarker {
+	markers := make([]hud.MapMarker, 0, len(g.cookStations))
+	for _, cs := range g.cookStations {
+		if cs.Used {
+			continue
+		}
+		markers = append(markers, hud.MapMarker{Kind: hud.CookStationMarker, Pos: cs.Pos, ItemType: cs.Recipe.Soup})
+	}
+	for _, gItem := range g.items {
+		if gItem.CategoryOf() == itemtype.CategoryWeapon {
+			markers = append(markers, hud.MapMarker{Kind: hud.WeaponMarker, Pos: gItem.Pos, ItemType: gItem.Type})
+		}
+	}
+	return markers
+}
+
+var _ hud.MapSource = (*GameScene)(nil)
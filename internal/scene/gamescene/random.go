@@ -0,0 +1,49 @@
+package gamescene
+
+import (
+	"math/rand"
+	"time"
+)
+
+// The random numbers of a run come from their own source so a saved run
+// continues with the same numbers. The source counts how many numbers it
+// returned. Seeding it again and skipping them restores its state.
+type countingSource struct {
+	source rand.Source64
+	seed   int64
+	draws  uint64
+}
+
+// Creates a source that continues after the given amount of draws.
+// A seed of 0 picks a new seed.
+func newCountingSource(seed int64, draws uint64) *countingSource {
+	if seed == 0 {
+		seed = time.Now().UnixNano()
+	}
+	s := &countingSource{}
+	s.Seed(seed)
+	for range draws {
+		s.Int63()
+	}
+	return s
+}
+
+func (s *countingSource) Int63() int64 {
+	s.draws++
+	return s.source.Int63()
+}
+
+// Every number of the source advances it by one step,
+// it does not matter which kind of number was drawn
+func (s *countingSource) Uint64() uint64 {
+	s.draws++
+	return s.source.Uint64()
+}
+
+func (s *countingSource) Seed(seed int64) {
+	s.source = rand.NewSource(seed).(rand.Source64)
+	s.seed = seed
+	s.draws = 0
+}
+
+var _ rand.Source64 = (*countingSource)(nil)
@@ -0,0 +1,75 @@
+package gamescene
+
+import (
+	"sort"
+	"time"
+
+	"github.com/N3moAhead/harvest/internal/entity/item/itemtype"
+)
+
+// RunInfo describes how far a run got. It is shown while the game is paused.
+type RunInfo struct {
+	Wave       int // The current wave starting at 1. It is 0 before the first wave.
+	TotalWaves int // The waves before the endless mode starts
+	Elapsed    time.Duration
+	MapKind    MapKind
+	Seed       int64 // The seed of the map. Arenas have no seed.
+	Weapons    []WeaponInfo
+	Soups      []SoupInfo
+}
+
+type WeaponInfo struct {
+	Name        string
+	Description string
+	Level       int
+	MaxLevel    int
+}
+
+type SoupInfo struct {
+	Type      itemtype.ItemType
+	Amount    int           // How many soups of the type were eaten
+	Remaining time.Duration // How long the soup keeps working
+}
+
+// Implemented by scenes that can describe the running game
+type RunInfoProvider interface {
+	RunInfo() RunInfo
+}
+
+func (g *GameScene) RunInfo() RunInfo {
+	info := RunInfo{
+		Wave:       g.currentWaveIndex + 1,
+		TotalWaves: totalWaves,
+		Elapsed:    g.playTime,
+		MapKind:    g.mapKind,
+		Seed:       g.World.GetSeed(),
+	}
+	for _, weapon := range g.inventory.Weapons {
+		if weapon == nil {
+			continue
+		}
+		info.Weapons = append(info.Weapons, WeaponInfo{
+			Name:        weapon.Name(),
+			Description: weapon.Description(),
+			Level:       weapon.Level(),
+			MaxLevel:    weapon.MaxLevel(),
+		})
+	}
+	now := time.Now()
+	for _, soup := range g.Player.Soups {
+		if !now.Before(soup.ExpiresAt) {
+			continue
+		}
+		info.Soups = append(info.Soups, SoupInfo{
+			Type:      soup.Type,
+			Amount:    g.inventory.Soups[soup.Type],
+			Remaining: soup.ExpiresAt.Sub(now),
+		})
+	}
+	sort.Slice(info.Soups, func(i, j int) bool {
+		return info.Soups[i].Type < info.Soups[j].Type
+	})
+	return info
+}
+
+var _ RunInfoProvider = (*GameScene)(nil)
@@ -0,0 +1,186 @@
+package gamescene
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/N3moAhead/harvest/internal/assets"
+	"github.com/N3moAhead/harvest/internal/component"
+	"github.com/N3moAhead/harvest/internal/cooking"
+	"github.com/N3moAhead/harvest/internal/entity/enemy"
+	"github.com/N3moAhead/harvest/internal/entity/item"
+	"github.com/N3moAhead/harvest/internal/savegame"
+	"github.com/N3moAhead/harvest/internal/soups"
+)
+
+// Implemented by scenes that can be saved and continued later on
+type Saveable interface {
+	Snapshot() *savegame.Snapshot
+}
+
+// Snapshot saves the run so it can be continued later on.
+// Chests that are still getting revealed grant their rewards right away.
+func (g *GameScene) Snapshot() *savegame.Snapshot {
+	for _, reveal := range g.chestReveals {
+		applyChestRewards(g, reveal.Rewards)
+	}
+	g.chestReveals = nil
+
+	now := time.Now()
+	snapshot := &savegame.Snapshot{
+		Version:      savegame.Version,
+		SavedAt:      now,
+		MapKind:      int(g.mapKind),
+		Seed:         g.World.GetSeed(),
+		PlayerLevel:  g.playerLevel,
+		Score:        g.Score,
+		WaveIndex:    g.currentWaveIndex,
+		PlayTime:     g.playTime,
+		SinceWave:    g.playTime - g.lastWaveStartTime,
+		SinceStation: g.playTime - g.lastCookStationSpawnTime,
+		Random: savegame.Random{
+			Seed:  g.rngSource.seed,
+			Draws: g.rngSource.draws,
+		},
+		Player: savegame.Player{
+			X:     g.Player.Pos.X,
+			Y:     g.Player.Pos.Y,
+			HP:    g.Player.Health.HP,
+			MaxHP: g.Player.Health.MaxHP,
+		},
+		Inventory: savegame.Inventory{
+			Vegetables: g.inventory.Vegetables,
+			Soups:      g.inventory.Soups,
+		},
+	}
+
+	for _, soup := range g.Player.Soups {
+		if now.Before(soup.ExpiresAt) {
+			snapshot.Player.Soups = append(snapshot.Player.Soups, savegame.Soup{Type: soup.Type, Remaining: soup.ExpiresAt.Sub(now)})
+		}
+	}
+	for slot, w := range g.inventory.Weapons {
+		if w != nil {
+			snapshot.Inventory.Weapons = append(snapshot.Inventory.Weapons, savegame.Weapon{Slot: slot, Type: w.GetType(), Level: w.Level()})
+		}
+	}
+	for _, e := range g.Enemies {
+		if !e.IsAlive() {
+			continue
+		}
+		state := e.State()
+		pos := e.GetPosition()
+		snapshot.Enemies = append(snapshot.Enemies, savegame.Enemy{
+			Type:  e.GetType().String(),
+			X:     pos.X,
+			Y:     pos.Y,
+			HP:    state.Health.HP,
+			MaxHP: state.Health.MaxHP,
+			Speed: state.Speed,
+			Scale: state.Scale,
+			Elite: state.Elite,
+		})
+	}
+	for _, i := range g.items {
+		snapshot.Items = append(snapshot.Items, savegame.Item{Type: i.Type, X: i.Pos.X, Y: i.Pos.Y})
+	}
+	// Used cook stations are neither drawn nor updated anymore
+	for _, station := range g.cookStations {
+		if !station.Used {
+			snapshot.CookStations = append(snapshot.CookStations, savegame.CookStation{
+				X:          station.Pos.X,
+				Y:          station.Pos.Y,
+				Soup:       station.Recipe.Soup,
+				CostFactor: station.CostFactor,
+			})
+		}
+	}
+	return snapshot
+}
+
+// RestoreGameScene continues a saved run. Parts of the snapshot
+// that are unknown to this version of the game are skipped.
+func RestoreGameScene(openPause func(), snapshot *savegame.Snapshot) *GameScene {
+	mapKind := MapKind(snapshot.MapKind)
+	gameWorld := newGameWorld(mapKind, snapshot.Seed)
+	g := newGameScene(openPause, snapshot.PlayerLevel, mapKind, gameWorld, newCountingSource(snapshot.Random.Seed, snapshot.Random.Draws))
+	g.initializeWaves()
+
+	now := time.Now()
+	g.Score = snapshot.Score
+	g.currentWaveIndex = min(snapshot.WaveIndex, totalWaves-1)
+	g.playTime = snapshot.PlayTime
+	g.lastWaveStartTime = g.playTime - snapshot.SinceWave
+	g.lastCookStationSpawnTime = g.playTime - snapshot.SinceStation
+
+	// --- Player ---
+	g.Player.Pos = component.NewVector2D(snapshot.Player.X, snapshot.Player.Y)
+	g.Player.Health = component.Health{HP: snapshot.Player.HP, MaxHP: snapshot.Player.MaxHP}
+	for _, saved := range snapshot.Player.Soups {
+		definition, ok := soups.Definitions[saved.Type]
+		if !ok {
+			fmt.Println("Warning: Skipped unknown soup of the saved run:", saved.Type)
+			continue
+		}
+		soup := *definition
+		soup.ExpiresAt = now.Add(saved.Remaining)
+		g.Player.Soups = append(g.Player.Soups, soup)
+	}
+	snapCamera(g)
+
+	// --- Inventory ---
+	for itemType, amount := range snapshot.Inventory.Vegetables {
+		g.inventory.Vegetables[itemType] = amount
+	}
+	for itemType, amount := range snapshot.Inventory.Soups {
+		g.inventory.Soups[itemType] = amount
+	}
+	for _, saved := range snapshot.Inventory.Weapons {
+		w, ok := createWeapon(saved.Type)
+		if !ok || saved.Slot < 0 || saved.Slot >= len(g.inventory.Weapons) {
+			fmt.Println("Warning: Skipped unknown weapon of the saved run:", saved.Type)
+			continue
+		}
+		for w.Level() < saved.Level {
+			if ok := w.LevelUp(); !ok {
+				break
+			}
+		}
+		g.inventory.Weapons[saved.Slot] = w
+	}
+
+	// --- Enemies ---
+	for _, saved := range snapshot.Enemies {
+		e := g.Spawner.Spawn(saved.Type, component.NewVector2D(saved.X, saved.Y))
+		if e == nil {
+			fmt.Println("Warning: Skipped unknown enemy of the saved run:", saved.Type)
+			continue
+		}
+		e.SetState(enemy.State{
+			Health: component.Health{HP: saved.HP, MaxHP: saved.MaxHP},
+			Speed:  saved.Speed,
+			Scale:  saved.Scale,
+			Elite:  saved.Elite,
+		})
+		g.Enemies = append(g.Enemies, e)
+	}
+
+	// --- Items & Cook Stations ---
+	for _, saved := range snapshot.Items {
+		g.items = append(g.items, item.NewItem(saved.Type, saved.X, saved.Y))
+	}
+	for _, saved := range snapshot.CookStations {
+		recipe, ok := cooking.RecipeDefinitions[saved.Soup]
+		if !ok {
+			fmt.Println("Warning: Skipped cook station with an unknown recipe of the saved run:", saved.Soup)
+			continue
+		}
+		g.cookStations = append(g.cookStations, cooking.NewCookStation(saved.X, saved.Y, recipe, saved.CostFactor))
+	}
+
+	assets.PlayMusic("game")
+
+	return g
+}
+
+var _ Saveable = (*GameScene)(nil)
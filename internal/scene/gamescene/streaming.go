@@ -0,0 +1,43 @@
+package gamescene
+
+import "github.com/N3moAhead/harvest/internal/entity/item/itemtype"
+
+// The world only keeps the chunks around the player loaded.
+// Things that end up outside of the loaded chunks can not collide with
+// the map anymore so they get moved back to the player or removed.
+func cullFarEntities(g *GameScene) {
+	// Enemies that fell behind come back from the edge of the screen
+	for _, e := range g.Enemies {
+		if !e.IsAlive() || g.World.IsLoadedAt(e.GetPosition()) {
+			continue
+		}
+		spawnPos, ok := getArenaEnemySpawnPosition(g)
+		if !ok {
+			spawnPos = getOffscreenSpawnPosition(g.rng, g.Camera.ViewRect(), 100.0)
+		}
+		e.SetPosition(g.World.FindWalkablePosition(spawnPos))
+	}
+
+	// Dropped vegetables are removed. Weapons, soups and chests stay
+	// where they are so the player can come back for them.
+	n := 0
+	for _, gItem := range g.items {
+		if gItem.CategoryOf() == itemtype.CategoryVegetable && !g.World.IsLoadedAt(gItem.Pos) {
+			continue
+		}
+		g.items[n] = gItem
+		n++
+	}
+	g.items = g.items[:n]
+
+	// New cook stations get spawned near the player anyway
+	n = 0
+	for _, cs := range g.cookStations {
+		if !g.World.IsLoadedAt(cs.Pos) {
+			continue
+		}
+		g.cookStations[n] = cs
+		n++
+	}
+	g.cookStations = g.cookStations[:n]
+}
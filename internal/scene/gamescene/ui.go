@@ -3,76 +3,44 @@ package gamescene
 import (
 	"fmt"
 
-	"github.com/N3moAhead/harvest/internal/assets"
 	"github.com/N3moAhead/harvest/internal/config"
+	"github.com/N3moAhead/harvest/internal/display"
 	"github.com/N3moAhead/harvest/internal/hud"
 	"github.com/N3moAhead/harvest/pkg/ui"
 	"github.com/hajimehoshi/ebiten/v2"
 	"github.com/hajimehoshi/ebiten/v2/ebitenutil"
 )
 
-func updateUI(g *GameScene) {
-	if g.isPaused {
-		g.gameOverlay.Update()
-	} else {
-		g.hud.Update()
-	}
-}
-
 func drawUI(g *GameScene, screen *ebiten.Image) {
-	if g.isPaused {
-		g.gameOverlay.Draw(screen)
-	} else {
-		// --- Drawing the HUD ---
-		fpsText := fmt.Sprintf("Fps: %d", int(ebiten.ActualFPS()))
-		ebitenutil.DebugPrintAt(screen, fmt.Sprintf("%s HP: %d / %d\n", fpsText, int(g.Player.Health.HP), int(g.Player.Health.MaxHP)), 10, config.SCREEN_HEIGHT-20)
-		g.inventory.Draw(screen)
-		g.hud.Draw(screen)
-	}
+	fpsText := fmt.Sprintf("Fps: %d", int(ebiten.ActualFPS()))
+	_, screenHeight := display.Size()
+	ebitenutil.DebugPrintAt(screen, fmt.Sprintf("%s HP: %d / %d\n", fpsText, int(g.Player.Health.HP), int(g.Player.Health.MaxHP)), 10, screenHeight-20)
+	g.inventory.Draw(screen)
+	g.hud.Draw(screen)
 }
 
 func initHUD(g *GameScene) *ui.UIManager {
-	newHUD := ui.NewUIManager()
+	screenWidth, screenHeight := display.Size()
+	newHUD := ui.NewUIManager(float64(screenWidth), float64(screenHeight))
 
 	inventoryDisplay := hud.NewInventoryDisplay(10, 10, g.inventory)
 	weaponDisplay := hud.NewWeaponDisplay(40, 10, g.inventory)
-	frameContainer := ui.NewContainer(5, 5, &ui.ContainerOptions{
+	frameContainer := ui.NewContainer(0, 0, &ui.ContainerOptions{
 		Direction: ui.Row,
 		Gap:       10,
 	})
 	frameContainer.AddChild(inventoryDisplay)
 	frameContainer.AddChild(weaponDisplay)
-	newHUD.AddElement(frameContainer)
+	newHUD.AddAnchoredElement(frameContainer, ui.AnchorTopLeft, 5, 5)
 
 	scoreDisplay := hud.NewScoreDisplay(&g.Score, "Score")
 
-	newHUD.AddElement(scoreDisplay)
-
-	return newHUD
-}
-
-func initGameOverlay(g *GameScene, backToMenu func()) *ui.UIManager {
-	newGameOverlay := ui.NewUIManager()
+	newHUD.AddAnchoredElement(scoreDisplay, ui.AnchorTopRight, 0, 20)
 
-	fontFace, ok := assets.AssetStore.GetFont("2p")
-	if !ok {
-		panic("Font Face could not be loaded")
-	}
+	// The arrows are added before the minimap so the minimap is drawn on top of them
+	newHUD.AddFullscreenElement(hud.NewOffscreenIndicators(g, g.Camera))
+	g.minimap = hud.NewMinimap(g)
+	newHUD.AddAnchoredElement(g.minimap, ui.AnchorBottomRight, -config.MINIMAP_MARGIN, -config.MINIMAP_MARGIN)
 
-	// Container
-	elementWidth := 300.0
-	containerDrawX := (float64(config.SCREEN_WIDTH) - elementWidth) / 2
-	container := ui.NewContainer(containerDrawX, 200, &ui.ContainerOptions{
-		Direction: ui.Col,
-		Gap:       10,
-	})
-	// Buttons
-	resumeBtn := ui.NewButton(0, 0, elementWidth, 50, "Resume", fontFace, func() { g.isPaused = false })
-	exitBtn := ui.NewButton(0, 0, elementWidth, 50, "Exit Game", fontFace, func() { backToMenu() })
-
-	container.AddChild(resumeBtn)
-	container.AddChild(exitBtn)
-	newGameOverlay.AddElement(container)
-
-	return newGameOverlay
+	return newHUD
 }
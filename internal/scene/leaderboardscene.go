@@ -0,0 +1,187 @@
+package scene
+
+import (
+	"fmt"
+	"image/color"
+	"strings"
+
+	"github.com/N3moAhead/harvest/internal/assets"
+	"github.com/N3moAhead/harvest/internal/display"
+	"github.com/N3moAhead/harvest/internal/input"
+	"github.com/N3moAhead/harvest/internal/leaderboard"
+	"github.com/N3moAhead/harvest/pkg/ui"
+	"github.com/hajimehoshi/ebiten/v2"
+	"golang.org/x/image/font"
+)
+
+// The leaderboard overlay shows the best runs and the history of the last runs
+type LeaderboardScene struct {
+	BaseScene
+	uiManager   *ui.UIManager
+	board       *leaderboard.Leaderboard
+	list        *ui.ScrollList
+	font        font.Face
+	sortButton  *ui.Button
+	showHistory bool // Shows the last runs instead of the best runs
+	sortKey     leaderboard.SortKey
+}
+
+func NewLeaderboardScene(board *leaderboard.Leaderboard) *LeaderboardScene {
+	fontFace, ok := assets.AssetStore.GetFont("2p")
+	if !ok {
+		panic("Unable to load font in leaderboard scene")
+	}
+	microFont, ok := assets.AssetStore.GetFont("micro")
+	if !ok {
+		panic("Unable to load font in leaderboard scene")
+	}
+
+	newUiManager := newUIManager()
+	newLeaderboardScene := &LeaderboardScene{
+		BaseScene: *NewBaseScene(),
+		uiManager: newUiManager,
+		board:     board,
+		font:      microFont,
+		sortKey:   leaderboard.SortByScore,
+	}
+	l := newLeaderboardScene
+
+	title := ui.NewLabel(0, 0, "Leaderboard", fontFace, color.White)
+	newUiManager.AddAnchoredElement(title, ui.AnchorTop, 0, 30)
+
+	// --- Tabs & Sorting ---
+	buttonWidth := 180.0
+	buttonHeight := 28.0
+	tabs := ui.NewContainer(0, 0, &ui.ContainerOptions{
+		Direction: ui.Row,
+		Gap:       10,
+	})
+	tabs.AddChild(ui.NewButton(0, 0, buttonWidth, buttonHeight, "Best Runs", microFont, func() { l.show(false, leaderboard.SortByScore) }))
+	tabs.AddChild(ui.NewButton(0, 0, buttonWidth, buttonHeight, "History", microFont, func() { l.show(true, leaderboard.SortByDate) }))
+	l.sortButton = ui.NewButton(0, 0, buttonWidth, buttonHeight, "", microFont, l.nextSortKey)
+	tabs.AddChild(l.sortButton)
+	newUiManager.AddAnchoredElement(tabs, ui.AnchorTop, 0, 70)
+
+	// --- Runs ---
+	screenWidth, screenHeight := display.Size()
+	l.list = ui.NewScrollList(0, 0, float64(screenWidth)-80, float64(screenHeight)-190, 8)
+	newUiManager.AddAnchoredElement(l.list, ui.AnchorTop, 0, 110)
+
+	backBtn := ui.NewButton(0, 0, 200, 40, "Back", fontFace, l.close)
+	newUiManager.AddAnchoredElement(backBtn, ui.AnchorBottom, 0, -20)
+
+	l.show(false, leaderboard.SortByScore)
+	return newLeaderboardScene
+}
+
+// The color of the run that just placed on the leaderboard
+var runPlaceColor = color.RGBA{R: 240, G: 200, B: 80, A: 255}
+
+// Shows the best runs or the history sorted by the key
+func (l *LeaderboardScene) show(history bool, sortKey leaderboard.SortKey) {
+	l.showHistory = history
+	l.sortKey = sortKey
+	l.sortButton.Text = "Sort: " + sortKey.String()
+
+	runs := l.board.Best
+	if history {
+		runs = l.board.History
+	}
+	l.list.ClearChildren()
+	if len(runs) == 0 {
+		l.list.AddChild(ui.NewLabel(0, 0, "No runs yet", l.font, infoDescriptionColor))
+		return
+	}
+	for i, run := range leaderboard.SortRuns(runs, sortKey) {
+		l.list.AddChild(newRunRow(i+1, run, l.font, color.White))
+	}
+}
+
+// Cycles through the sort keys
+func (l *LeaderboardScene) nextSortKey() {
+	for i, key := range leaderboard.SortKeys {
+		if key == l.sortKey {
+			l.show(l.showHistory, leaderboard.SortKeys[(i+1)%len(leaderboard.SortKeys)])
+			return
+		}
+	}
+	l.show(l.showHistory, leaderboard.SortByDate)
+}
+
+// The scene manager removes the overlay once it stopped running
+func (l *LeaderboardScene) close() {
+	l.SetIsRunning(false)
+}
+
+func (l *LeaderboardScene) Update() error {
+	updateUI(l.uiManager)
+	if input.IsActionJustPressed(input.ActionCancel) {
+		l.close()
+	}
+	return nil
+}
+
+func (l *LeaderboardScene) Draw(screen *ebiten.Image) {
+	drawOverlayBackground(screen)
+	l.uiManager.Draw(screen)
+}
+
+func (l *LeaderboardScene) IsOverlay() bool {
+	return true
+}
+
+var _ Overlay = (*LeaderboardScene)(nil)
+
+// The widths of the columns of a run. The labels get a fixed width so the columns line up.
+var runColumnWidths = []float64{50, 170, 130, 100, 170}
+
+// A run takes two lines. The first shows how good it was, the second how it was played.
+func newRunRow(place int, run leaderboard.Run, fnt font.Face, clr color.Color) *ui.Container {
+	row := ui.NewContainer(0, 0, &ui.ContainerOptions{
+		Direction: ui.Col,
+		Gap:       2,
+	})
+	row.AddChild(newRunColumns(place, run, fnt, clr))
+
+	details := run.MapKind
+	if run.Seed != 0 {
+		details += fmt.Sprintf(" (Seed %d)", run.Seed)
+	}
+	if len(run.Weapons) > 0 {
+		details += " - " + strings.Join(run.Weapons, ", ")
+	}
+	// The details start below the score
+	detailsLine := ui.NewContainer(0, 0, &ui.ContainerOptions{
+		Direction: ui.Row,
+	})
+	indent := ui.NewLabel(0, 0, "", fnt, clr)
+	indent.SetSize(runColumnWidths[0], 0)
+	detailsLine.AddChild(indent)
+	detailsLine.AddChild(ui.NewLabel(0, 0, details, fnt, infoDescriptionColor))
+	row.AddChild(detailsLine)
+	return row
+}
+
+// The first line of a run
+func newRunColumns(place int, run leaderboard.Run, fnt font.Face, clr color.Color) *ui.Container {
+	columns := ui.NewContainer(0, 0, &ui.ContainerOptions{
+		Direction: ui.Row,
+	})
+	for i, txt := range runColumns(place, run) {
+		cell := ui.NewLabel(0, 0, txt, fnt, clr)
+		_, height := cell.GetSize()
+		cell.SetSize(runColumnWidths[i], height)
+		columns.AddChild(cell)
+	}
+	return columns
+}
+
+func runColumns(place int, run leaderboard.Run) []string {
+	return []string{
+		fmt.Sprintf("#%d", place),
+		fmt.Sprintf("Score %d", run.Score),
+		"Time " + formatPlayTime(run.SurvivalTime),
+		fmt.Sprintf("Wave %d", run.Wave),
+		run.Date.Local().Format("2006-01-02 15:04"),
+	}
+}
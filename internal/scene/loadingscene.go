@@ -1,11 +1,13 @@
 package scene
 
 import (
+	"errors"
 	"image/color"
 	"log"
 
 	"github.com/N3moAhead/harvest/internal/assets"
-	"github.com/N3moAhead/harvest/internal/config"
+	"github.com/N3moAhead/harvest/internal/entity/enemy"
+	"github.com/N3moAhead/harvest/internal/entity/player"
 	"github.com/N3moAhead/harvest/pkg/ui"
 	"github.com/hajimehoshi/ebiten/v2"
 )
@@ -21,16 +23,10 @@ func NewLoadingScene() *LoadingScene {
 	if !ok {
 		panic("Unable to load font '2p' in NewLoadingScene")
 	}
-	text := ui.NewLabel(50, 50, "Insert Coin", fontFace, color.RGBA{R: 255, G: 255, B: 255, A: 255})
-	textWidth, textHeight := text.GetSize()
-	offsetX := (config.SCREEN_WIDTH - textWidth) / 2
-	offsetY := (config.SCREEN_HEIGHT - textHeight) / 2
-	drawX := offsetX
-	drawY := offsetY - float64(text.Font.Metrics().Ascent/64)
-	text.SetPosition(drawX, drawY)
+	text := ui.NewLabel(0, 0, "Insert Coin", fontFace, color.RGBA{R: 255, G: 255, B: 255, A: 255})
 
-	newUiManager := ui.NewUIManager()
-	newUiManager.AddElement(text)
+	newUiManager := newUIManager()
+	newUiManager.AddAnchoredElement(text, ui.AnchorCenter, 0, 0)
 
 	newLoadingScene := &LoadingScene{
 		BaseScene:   *NewBaseScene(),
@@ -39,15 +35,15 @@ func NewLoadingScene() *LoadingScene {
 	}
 
 	// Play game loading sound
-	loadSound, ok := assets.AssetStore.GetSFXData("game_loads_sound")
-	if ok {
-		sfxPlayer := assets.AudioContext.NewPlayerFromBytes(loadSound)
-		sfxPlayer.Play()
-	}
+	assets.PlaySound("game_loads_sound")
 
 	// Loading all assets in a goroutine to not block the ui updates
 	go func() {
 		assets.LoadAllAssets()
+		// Broken animation definitions should fail right away instead of in the middle of a run
+		if err := errors.Join(enemy.CheckAnimations(), player.CheckAnimations()); err != nil {
+			panic(err)
+		}
 		log.Println("All assets loaded.")
 		close(newLoadingScene.loadingDone)
 	}()
@@ -60,7 +56,7 @@ func (l *LoadingScene) Draw(screen *ebiten.Image) {
 }
 
 func (l *LoadingScene) Update() error {
-	l.uiManager.Update()
+	updateUI(l.uiManager)
 
 	if l.loadingDone != nil {
 		select {
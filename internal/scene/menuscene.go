@@ -1,19 +1,21 @@
 package scene
 
 import (
-	"bytes"
 	"fmt"
 	"image/color"
 	"math"
+	"time"
 
 	"github.com/N3moAhead/harvest/internal/assets"
+	"github.com/N3moAhead/harvest/internal/camera"
 	"github.com/N3moAhead/harvest/internal/component"
-	"github.com/N3moAhead/harvest/internal/config"
+	"github.com/N3moAhead/harvest/internal/display"
+	"github.com/N3moAhead/harvest/internal/drawstats"
 	"github.com/N3moAhead/harvest/internal/hud"
+	"github.com/N3moAhead/harvest/internal/scene/gamescene"
 	"github.com/N3moAhead/harvest/internal/world"
 	"github.com/N3moAhead/harvest/pkg/ui"
 	"github.com/hajimehoshi/ebiten/v2"
-	"github.com/hajimehoshi/ebiten/v2/audio"
 )
 
 type MenuScene struct {
@@ -21,13 +23,15 @@ type MenuScene struct {
 	uiManager    *ui.UIManager
 	icon         *ebiten.Image
 	world        *world.World
+	camera       *camera.Camera
 	targetPos    component.Vector2D
 	currentAngle float64
 	isRunning    bool
 	angularSpeed float64
 }
 
-func NewMenuScene(setExitGame func(), stats PlayerStats) *MenuScene {
+// continueRun is nil if there is no saved run to continue
+func NewMenuScene(setExitGame func(), openSettings func(), openLeaderboard func(), continueRun func(), stats PlayerStats, selectedMap gamescene.MapKind, selectMap func(gamescene.MapKind)) *MenuScene {
 	icon, ok := assets.AssetStore.GetImage("menu-icon")
 	if !ok {
 		panic("menu-icon nicht im AssetStore gefunden")
@@ -41,63 +45,82 @@ func NewMenuScene(setExitGame func(), stats PlayerStats) *MenuScene {
 	if !ok {
 		panic("Unable to load font in new base scene")
 	}
-	newUiManager := ui.NewUIManager()
+	newUiManager := newUIManager()
 	newMenuScene := &MenuScene{
 		BaseScene:    *NewBaseScene(),
 		uiManager:    newUiManager,
 		icon:         icon,
 		isRunning:    true,
-		world:        world.NewWorld(400, 400),
+		world:        world.NewWorld(time.Now().UnixNano()),
+		camera:       camera.NewCamera(display.Size()),
 		angularSpeed: 0.1,
-		targetPos: component.NewVector2D(float64((config.WIDTH_IN_TILES*config.TILE_SIZE)/2),
-			float64((config.HEIGHT_IN_TILES*config.TILE_SIZE)/2.0)),
+		targetPos:    component.NewVector2D(0, 0),
 		currentAngle: 0.0,
 	}
 
 	startBtn := ui.NewButton(0, 0, 150, 40, "Start", fontFace, func() { newMenuScene.SetIsRunning(false) })
+	// Switches between the procedural map and the handcrafted arena
+	var mapBtn *ui.Button
+	mapBtn = ui.NewButton(0, 0, 150, 40, mapButtonText(selectedMap), microFont, func() {
+		if selectedMap == gamescene.ProceduralMap {
+			selectedMap = gamescene.ArenaMap
+		} else {
+			selectedMap = gamescene.ProceduralMap
+		}
+		selectMap(selectedMap)
+		mapBtn.Text = mapButtonText(selectedMap)
+	})
 	endGameBtn := ui.NewButton(0, 0, 150, 40, "Exit", fontFace, setExitGame)
-	container := ui.NewContainer((config.SCREEN_WIDTH-150)/2, 350, &ui.ContainerOptions{
+	container := ui.NewContainer(0, 0, &ui.ContainerOptions{
 		Direction: ui.Col,
 		Gap:       10,
 	})
-	container.AddChild(startBtn)
+	// A saved run can be continued next to starting a new one
+	startRow := ui.NewContainer(0, 0, &ui.ContainerOptions{
+		Direction: ui.Row,
+		Gap:       10,
+	})
+	if continueRun != nil {
+		startRow.AddChild(ui.NewButton(0, 0, 150, 40, "Continue", microFont, continueRun))
+	}
+	startRow.AddChild(startBtn)
+	container.AddChild(startRow)
+	container.AddChild(mapBtn)
 	container.AddChild(endGameBtn)
-	newUiManager.AddElement(container)
+	// The buttons start right below the icon
+	newUiManager.AddAnchoredElement(container, ui.AnchorTop, 0, 350)
+	// There is no space left below the icon so the settings sit in the corner
+	settingsBtn := ui.NewButton(0, 0, 150, 40, "Settings", microFont, openSettings)
+	newUiManager.AddAnchoredElement(settingsBtn, ui.AnchorBottomLeft, 10, -10)
+	leaderboardBtn := ui.NewButton(0, 0, 150, 40, "Leaderboard", microFont, openLeaderboard)
+	newUiManager.AddAnchoredElement(leaderboardBtn, ui.AnchorBottomRight, -10, -10)
 	highScoreDisplay := hud.NewScoreDisplay(&stats.highScore, "Highscore")
-	newUiManager.AddElement(highScoreDisplay)
+	newUiManager.AddAnchoredElement(highScoreDisplay, ui.AnchorTopRight, 0, 20)
 
-	statsContainer := ui.NewContainer(10, 10, &ui.ContainerOptions{
+	statsContainer := ui.NewContainer(0, 0, &ui.ContainerOptions{
 		Direction: ui.Col,
 		Gap:       10,
 	})
 	levelDisplay := ui.NewLabel(0, 0, fmt.Sprintf("Player Level: %d", stats.playerLevel), microFont, color.White)
 	statsContainer.AddChild(levelDisplay)
-	newUiManager.AddElement(statsContainer)
-
-	music, ok := assets.AssetStore.GetMusicData("menu")
-	if ok {
-		musicBytesReader := bytes.NewReader(music)
-		loop := audio.NewInfiniteLoop(musicBytesReader, int64(len(music)))
-		if assets.MusicPlayer != nil {
-			assets.MusicPlayer.Close()
-			assets.MusicPlayer, _ = assets.AudioContext.NewPlayer(loop)
-		}
-		assets.MusicPlayer, _ = assets.AudioContext.NewPlayer(loop)
-		assets.MusicPlayer.Play()
-	} else {
-		fmt.Println("Warning: could not load menu music")
-	}
+	newUiManager.AddAnchoredElement(statsContainer, ui.AnchorTopLeft, 10, 10)
+
+	assets.PlayMusic("menu")
 
 	return newMenuScene
 }
 
+func mapButtonText(mapKind gamescene.MapKind) string {
+	return "Map: " + mapKind.String()
+}
+
 func (l *MenuScene) Draw(screen *ebiten.Image) {
 	screenWidth := screen.Bounds().Dx()
 	iconWidth := l.icon.Bounds().Dx()
 	scale := 0.14 // Scale the icon
 	scaledW := float64(iconWidth) * scale
 
-	l.world.Draw(screen)
+	l.world.Draw(screen, l.camera)
 
 	op := &ebiten.DrawImageOptions{}
 	op.GeoM.Scale(scale, scale)
@@ -105,7 +128,7 @@ func (l *MenuScene) Draw(screen *ebiten.Image) {
 		(float64(screenWidth)-scaledW)/2, // horziontal center
 		30,                               // 30px from top
 	)
-	screen.DrawImage(l.icon, op)
+	drawstats.DrawImage(screen, l.icon, op)
 
 	l.uiManager.Draw(screen)
 }
@@ -113,8 +136,9 @@ func (l *MenuScene) Draw(screen *ebiten.Image) {
 func (l *MenuScene) Update() error {
 	dt := 1.0 / float64(ebiten.TPS())
 
-	circleCenterX := float64((config.WIDTH_IN_TILES * config.TILE_SIZE) / 2)
-	circleCenterY := float64((config.HEIGHT_IN_TILES * config.TILE_SIZE) / 2.0)
+	// The camera circles around the origin of the world
+	circleCenterX := 0.0
+	circleCenterY := 0.0
 
 	radius := 650.0
 
@@ -130,8 +154,11 @@ func (l *MenuScene) Update() error {
 	l.targetPos.X = circleCenterX + radius*math.Cos(l.currentAngle)
 	l.targetPos.Y = circleCenterY + radius*math.Sin(l.currentAngle)
 
-	l.world.Update(l.targetPos, config.SCREEN_WIDTH, config.SCREEN_HEIGHT, dt)
-	l.uiManager.Update()
+	l.world.Update(l.targetPos)
+	l.camera.SetScreenSize(display.Size())
+	l.camera.Follow(l.targetPos, component.Vector2D{})
+	l.camera.Update(dt)
+	updateUI(l.uiManager)
 	return nil
 }
 
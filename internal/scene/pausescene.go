@@ -0,0 +1,138 @@
+package scene
+
+import (
+	"fmt"
+	"image/color"
+	"strings"
+	"time"
+
+	"github.com/N3moAhead/harvest/internal/assets"
+	"github.com/N3moAhead/harvest/internal/display"
+	"github.com/N3moAhead/harvest/internal/input"
+	"github.com/N3moAhead/harvest/internal/scene/gamescene"
+	"github.com/N3moAhead/harvest/pkg/ui"
+	"github.com/hajimehoshi/ebiten/v2"
+	"golang.org/x/image/font"
+)
+
+// The pause overlay is shown on top of the game. The game below is not
+// updated while the overlay is on the stack. It shows how far the run got.
+type PauseScene struct {
+	BaseScene
+	uiManager *ui.UIManager
+}
+
+func NewPauseScene(info gamescene.RunInfo, openSettings func(), restartRun func(), saveAndQuit func(), exitToMenu func()) *PauseScene {
+	fontFace, ok := assets.AssetStore.GetFont("2p")
+	if !ok {
+		panic("Unable to load font in pause scene")
+	}
+	microFont, ok := assets.AssetStore.GetFont("micro")
+	if !ok {
+		panic("Unable to load font in pause scene")
+	}
+
+	newUiManager := newUIManager()
+	newPauseScene := &PauseScene{
+		BaseScene: *NewBaseScene(),
+		uiManager: newUiManager,
+	}
+
+	title := ui.NewLabel(0, 0, "Paused", fontFace, color.White)
+	newUiManager.AddAnchoredElement(title, ui.AnchorTop, 0, 30)
+
+	// --- Run Info ---
+	_, screenHeight := display.Size()
+	infoList := ui.NewScrollList(0, 0, 480, float64(screenHeight)-120, 6)
+	addRunInfo(infoList, info, fontFace, microFont)
+	newUiManager.AddAnchoredElement(infoList, ui.AnchorTopLeft, 40, 90)
+
+	// --- Buttons ---
+	elementWidth := 280.0
+	container := ui.NewContainer(0, 0, &ui.ContainerOptions{
+		Direction: ui.Col,
+		Gap:       10,
+	})
+	container.AddChild(ui.NewButton(0, 0, elementWidth, 50, "Resume", fontFace, newPauseScene.resume))
+	container.AddChild(ui.NewButton(0, 0, elementWidth, 50, "Restart", fontFace, restartRun))
+	container.AddChild(ui.NewButton(0, 0, elementWidth, 50, "Settings", fontFace, openSettings))
+	container.AddChild(ui.NewButton(0, 0, elementWidth, 50, "Save & Quit", fontFace, saveAndQuit))
+	container.AddChild(ui.NewButton(0, 0, elementWidth, 50, "Exit Game", fontFace, exitToMenu))
+	newUiManager.AddAnchoredElement(container, ui.AnchorTopRight, -40, 90)
+
+	return newPauseScene
+}
+
+var (
+	infoHeadingColor     = color.RGBA{R: 240, G: 200, B: 80, A: 255}
+	infoDescriptionColor = color.RGBA{R: 170, G: 170, B: 170, A: 255}
+)
+
+// Adds a line for every part of the run info to the list
+func addRunInfo(list *ui.ScrollList, info gamescene.RunInfo, headingFont, textFont font.Face) {
+	addLine := func(txt string, fnt font.Face, clr color.Color) {
+		list.AddChild(ui.NewLabel(0, 0, txt, fnt, clr))
+	}
+
+	addLine(fmt.Sprintf("Wave: %d / %d", info.Wave, info.TotalWaves), textFont, color.White)
+	addLine("Time: "+formatPlayTime(info.Elapsed), textFont, color.White)
+	mapText := "Map: " + info.MapKind.String()
+	if info.Seed != 0 {
+		mapText += fmt.Sprintf(" (Seed %d)", info.Seed)
+	}
+	addLine(mapText, textFont, color.White)
+
+	addLine("Weapons", headingFont, infoHeadingColor)
+	if len(info.Weapons) == 0 {
+		addLine("No weapons yet", textFont, infoDescriptionColor)
+	}
+	for _, weapon := range info.Weapons {
+		addLine(fmt.Sprintf("%s  Lv %d / %d", weapon.Name, weapon.Level, weapon.MaxLevel), textFont, color.White)
+		// Descriptions use slashes to start a new line
+		for _, line := range strings.Split(weapon.Description, "/") {
+			addLine("  "+strings.TrimSpace(line), textFont, infoDescriptionColor)
+		}
+	}
+
+	addLine("Soups", headingFont, infoHeadingColor)
+	if len(info.Soups) == 0 {
+		addLine("No active soups", textFont, infoDescriptionColor)
+	}
+	for _, soup := range info.Soups {
+		addLine(fmt.Sprintf("%s x%d  %ds left", soup.Type, soup.Amount, int(soup.Remaining.Seconds())), textFont, color.White)
+	}
+}
+
+// Formats the time as minutes and seconds, hours are only shown in long runs
+func formatPlayTime(d time.Duration) string {
+	seconds := int(d.Seconds())
+	if seconds >= 3600 {
+		return fmt.Sprintf("%d:%02d:%02d", seconds/3600, seconds/60%60, seconds%60)
+	}
+	return fmt.Sprintf("%02d:%02d", seconds/60, seconds%60)
+}
+
+// The scene manager removes the overlay once it stopped running
+func (p *PauseScene) resume() {
+	p.SetIsRunning(false)
+}
+
+func (p *PauseScene) Update() error {
+	updateUI(p.uiManager)
+	// Pressing pause again or cancelling resumes the game
+	if input.IsActionJustPressed(input.ActionPause) || input.IsActionJustPressed(input.ActionCancel) {
+		p.resume()
+	}
+	return nil
+}
+
+func (p *PauseScene) Draw(screen *ebiten.Image) {
+	drawOverlayBackground(screen)
+	p.uiManager.Draw(screen)
+}
+
+func (p *PauseScene) IsOverlay() bool {
+	return true
+}
+
+var _ Overlay = (*PauseScene)(nil)
@@ -1,8 +1,13 @@
 package scene
 
 import (
-	"github.com/N3moAhead/harvest/internal/config"
+	"image/color"
+
+	"github.com/N3moAhead/harvest/internal/display"
+	"github.com/N3moAhead/harvest/internal/input"
+	"github.com/N3moAhead/harvest/pkg/ui"
 	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/vector"
 )
 
 type Scene interface {
@@ -13,6 +18,34 @@ type Scene interface {
 	SetIsRunning(running bool)
 }
 
+// Overlay scenes are drawn on top of the scene below them on the stack.
+// The scenes below keep their state but are not updated.
+type Overlay interface {
+	Scene
+	IsOverlay() bool
+}
+
+// Darkens the scenes below an overlay so the overlay is easy to read
+var overlayBackground = color.RGBA{R: 0, G: 0, B: 0, A: 170}
+
+func drawOverlayBackground(screen *ebiten.Image) {
+	vector.DrawFilledRect(screen, 0, 0, float32(screen.Bounds().Dx()), float32(screen.Bounds().Dy()), overlayBackground, false)
+}
+
+// Creates a ui manager for the current resolution of the game
+func newUIManager() *ui.UIManager {
+	width, height := display.Size()
+	return ui.NewUIManager(float64(width), float64(height))
+}
+
+// Updates the ui with the input of this tick. Anchored
+// elements move when a different resolution got chosen.
+func updateUI(uiManager *ui.UIManager) {
+	width, height := display.Size()
+	uiManager.SetScreenSize(float64(width), float64(height))
+	uiManager.UpdateWithInput(input.GetInputState().UI())
+}
+
 type BaseScene struct {
 	sceneRunning bool
 }
@@ -30,7 +63,7 @@ func (b *BaseScene) Update() error {
 func (b *BaseScene) Draw(screen *ebiten.Image) {}
 
 func (b *BaseScene) Layout(outsideWidth, outsideHeight int) (screenWidth, screenHeight int) {
-	return config.SCREEN_WIDTH, config.SCREEN_HEIGHT
+	return display.Size()
 }
 
 func (b *BaseScene) IsRunning() bool {
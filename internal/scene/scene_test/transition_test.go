@@ -0,0 +1,73 @@
+package scene_test
+
+import (
+	"testing"
+
+	"github.com/N3moAhead/harvest/internal/scene"
+)
+
+func TestTransitionSwitchesHalfway(t *testing.T) {
+	for _, kind := range []scene.TransitionKind{scene.FadeTransition, scene.WipeTransition} {
+		switches := 0
+		transition := scene.NewTransition(kind, 1, func() { switches++ })
+
+		steps := []struct {
+			finished bool
+			switches int
+			coverage float64
+		}{
+			{false, 0, 0.5},
+			{false, 1, 1}, // Halfway the screen is covered and the scene switches
+			{false, 1, 0.5},
+			{true, 1, 0},
+		}
+		for i, step := range steps {
+			finished := transition.Update(0.25)
+			if finished != step.finished || switches != step.switches {
+				t.Errorf("kind %d step %d: expected finished %v after %d switches, got %v after %d", kind, i, step.finished, step.switches, finished, switches)
+			}
+			if transition.Switched() != (step.switches > 0) {
+				t.Errorf("kind %d step %d: expected switched to be %v", kind, i, step.switches > 0)
+			}
+			if coverage := transition.Coverage(); coverage != step.coverage {
+				t.Errorf("kind %d step %d: expected the coverage %v, got %v", kind, i, step.coverage, coverage)
+			}
+		}
+	}
+}
+
+func TestNoTransitionSwitchesRightAway(t *testing.T) {
+	switches := 0
+	transition := scene.NewTransition(scene.NoTransition, 1, func() { switches++ })
+	if !transition.Update(0) {
+		t.Error("Expected the transition to finish in its first update")
+	}
+	if switches != 1 {
+		t.Errorf("Expected one switch, got %d", switches)
+	}
+	if coverage := transition.Coverage(); coverage != 0 {
+		t.Errorf("Expected nothing to be covered, got %v", coverage)
+	}
+}
+
+func TestTransitionCoverageStaysBetweenZeroAndOne(t *testing.T) {
+	for _, dt := range []float64{0.01, 0.3, 0.7, 2} {
+		switches := 0
+		transition := scene.NewTransition(scene.FadeTransition, 1, func() { switches++ })
+		for tick := 0; ; tick++ {
+			finished := transition.Update(dt)
+			if coverage := transition.Coverage(); coverage < 0 || coverage > 1 {
+				t.Fatalf("dt %v: expected the coverage to be between 0 and 1, got %v", dt, coverage)
+			}
+			if finished {
+				break
+			}
+			if tick > 1000 {
+				t.Fatalf("dt %v: expected the transition to finish", dt)
+			}
+		}
+		if switches != 1 {
+			t.Errorf("dt %v: expected one switch, got %d", dt, switches)
+		}
+	}
+}
@@ -3,30 +3,79 @@ package scene
 import (
 	"errors"
 	"fmt"
+	"time"
 
+	"github.com/N3moAhead/harvest/internal/assets"
 	"github.com/N3moAhead/harvest/internal/config"
+	"github.com/N3moAhead/harvest/internal/display"
+	"github.com/N3moAhead/harvest/internal/drawstats"
+	"github.com/N3moAhead/harvest/internal/leaderboard"
+	"github.com/N3moAhead/harvest/internal/savegame"
 	"github.com/N3moAhead/harvest/internal/scene/gamescene"
+	"github.com/N3moAhead/harvest/internal/toast"
 	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/ebitenutil"
+	"github.com/hajimehoshi/ebiten/v2/inpututil"
 )
 
 type SceneId string
 
 const (
-	LOADING_SCENE SceneId = "game_loading_scene"
-	MENU_SCENE    SceneId = "menu_scene"
-	GAME_SCENE    SceneId = "game_scene"
-	SCORE_SCENE   SceneId = "game_over_scene"
+	LOADING_SCENE     SceneId = "game_loading_scene"
+	MENU_SCENE        SceneId = "menu_scene"
+	GAME_SCENE        SceneId = "game_scene"
+	SCORE_SCENE       SceneId = "game_over_scene"
+	SETTINGS_SCENE    SceneId = "settings_scene"
+	PAUSE_SCENE       SceneId = "pause_scene"
+	CONFIRM_SCENE     SceneId = "confirm_scene"
+	LEADERBOARD_SCENE SceneId = "leaderboard_scene"
 )
 
+// The scene that follows when the scene at the bottom of the stack ends
+// LoadingScene -> MenuScene -> GameScene -> ScoreScene -> MenuScene
+var followUpScenes = map[SceneId]SceneId{
+	LOADING_SCENE: MENU_SCENE,
+	MENU_SCENE:    GAME_SCENE,
+	GAME_SCENE:    SCORE_SCENE,
+	SCORE_SCENE:   MENU_SCENE,
+}
+
+// How the screen changes to a scene that follows another one
+var sceneTransitions = map[SceneId]TransitionKind{
+	MENU_SCENE:  FadeTransition,
+	GAME_SCENE:  WipeTransition,
+	SCORE_SCENE: FadeTransition,
+}
+
+// A scene on the stack together with the id it was created from
+type stackEntry struct {
+	id    SceneId
+	scene Scene
+}
+
 type SceneManager struct {
-	currentScene SceneId
-	loadingScene Scene
-	menuScene    Scene
-	gameScene    Scene
-	scoreScene   Scene
+	// Creates a new scene for every id
+	registry map[SceneId]func() Scene
+	// Only the scene on top is updated. Overlays show the scenes below them.
+	stack      []stackEntry
+	transition *Transition // The running transition. Nil if there is none.
 	// If set to true the game will end in the next update loop
-	exitGame bool
-	stats    PlayerStats
+	exitGame    bool
+	stats       PlayerStats
+	leaderboard *leaderboard.Leaderboard
+	selectedMap gamescene.MapKind // The map chosen in the menu
+	// The seed of the next game. A seed of 0 generates a new map.
+	nextSeed int64
+	// The saved run the next game continues. Nil starts a new run.
+	continuedRun *savegame.Snapshot
+	// What the confirm overlay asks and does if it gets confirmed
+	confirmQuestion string
+	onConfirm       func()
+	// Shows the images drawn in the last frame. Toggled with F3.
+	showDebugOverlay bool
+	// The scenes draw on the canvas in the resolution of the game.
+	// It is scaled to the window afterwards.
+	canvas *ebiten.Image
 }
 
 type PlayerStats struct {
@@ -35,87 +84,153 @@ type PlayerStats struct {
 	lastGameXPEarned uint
 	playerXP         uint // 10.000 Score Points = 1 XP
 	playerLevel      uint // 10 XP => 1 Player Level
+	lastGamePlace    int  // The place of the last game on the leaderboard. 0 if it did not place.
 }
 
 func NewSceneManager() *SceneManager {
-	return &SceneManager{
-		currentScene: LOADING_SCENE,
-		loadingScene: NewLoadingScene(),
-	}
-}
-
-func (s *SceneManager) getCurrentScene() Scene {
-	switch s.currentScene {
-	case LOADING_SCENE:
-		return s.loadingScene
-	case MENU_SCENE:
-		return s.menuScene
-	case GAME_SCENE:
-		return s.gameScene
-	case SCORE_SCENE:
-		return s.scoreScene
-	default:
-		fmt.Println("Warning: Could not load currentScene in scene manager. Falling back to menu!")
-		s.setNextScene(MENU_SCENE)
-		return s.getCurrentScene()
-	}
-}
-
-func (s *SceneManager) setNextScene(scene SceneId) {
-	// Its not possible to set the loading scene because
-	// its only used at startup
-	switch scene {
-	case MENU_SCENE:
-		fmt.Println("Switched Scene to Menu")
-		s.menuScene = NewMenuScene(s.setExitGame, s.stats)
-		s.currentScene = MENU_SCENE
-	case GAME_SCENE:
-		fmt.Println("Switched Scene to Game Scene")
-		s.gameScene = gamescene.NewGameScene(func() { s.updateHighScore(); s.setNextScene(MENU_SCENE) }, s.stats.playerLevel)
-		s.currentScene = GAME_SCENE
-	case SCORE_SCENE:
-		fmt.Println("Switched Scene to Score")
-		s.scoreScene = NewScoreScene(s.stats)
-		s.currentScene = SCORE_SCENE
-	default:
-		fmt.Println("Warning: Switched to menu, received undefined SceneId: ", scene)
-		s.setNextScene(MENU_SCENE)
-	}
-}
-
-// A scene ends and this functions returns a logical
-// follow up scene in the following direction
-// LoadingScene -> MenuScene -> GameScene -> ScoreScene -> MenuScene
-func (s *SceneManager) determineFollowUpScene() SceneId {
-	switch s.currentScene {
-	case LOADING_SCENE:
-		return MENU_SCENE
-	case MENU_SCENE:
-		return GAME_SCENE
-	case GAME_SCENE:
-		return SCORE_SCENE
-	case SCORE_SCENE:
-		return MENU_SCENE
-	default:
-		fmt.Println("Warning: Follow up -> MenuScene Could not determine follow up scene the scene:", s.currentScene)
-		return MENU_SCENE
+	s := &SceneManager{}
+	board, err := leaderboard.Load(leaderboard.Path())
+	if err != nil {
+		fmt.Println("Warning:", err)
+	}
+	s.leaderboard = board
+	// The best run of earlier sessions is the high score to beat
+	if len(board.Best) > 0 {
+		s.stats.highScore = board.Best[0].Score
+	}
+	// The scenes are created when they are shown so they read the current stats
+	s.registry = map[SceneId]func() Scene{
+		LOADING_SCENE: func() Scene { return NewLoadingScene() },
+		MENU_SCENE: func() Scene {
+			return NewMenuScene(s.setExitGame, s.openSettings, s.openLeaderboard, s.continueRunAction(), s.stats, s.selectedMap, s.setSelectedMap)
+		},
+		GAME_SCENE: func() Scene {
+			if snapshot := s.continuedRun; snapshot != nil {
+				s.continuedRun = nil
+				return gamescene.RestoreGameScene(s.openPause, snapshot)
+			}
+			seed := s.nextSeed
+			s.nextSeed = 0
+			return gamescene.NewGameScene(s.openPause, s.stats.playerLevel, s.selectedMap, seed)
+		},
+		SCORE_SCENE:    func() Scene { return NewScoreScene(s.stats, s.leaderboard.Best) },
+		SETTINGS_SCENE: func() Scene { return NewSettingsScene() },
+		PAUSE_SCENE: func() Scene {
+			return NewPauseScene(s.runInfo(), s.openSettings, s.confirmRestart, s.saveAndQuit, s.confirmExitToMenu)
+		},
+		CONFIRM_SCENE:     func() Scene { return NewConfirmScene(s.confirmQuestion, s.onConfirm) },
+		LEADERBOARD_SCENE: func() Scene { return NewLeaderboardScene(s.leaderboard) },
+	}
+	s.stack = []stackEntry{s.newEntry(LOADING_SCENE)}
+	return s
+}
+
+func (s *SceneManager) newEntry(id SceneId) stackEntry {
+	create, ok := s.registry[id]
+	if !ok {
+		fmt.Println("Warning: Switched to menu, received undefined SceneId: ", id)
+		id = MENU_SCENE
+		create = s.registry[MENU_SCENE]
+	}
+	fmt.Println("Switched Scene to", id)
+	return stackEntry{id: id, scene: create()}
+}
+
+// Push shows a new scene on top of the current one
+func (s *SceneManager) Push(id SceneId) {
+	s.stack = append(s.stack, s.newEntry(id))
+}
+
+// Pop removes the scene on top and continues the scene below.
+// The scene at the bottom of the stack is never removed.
+func (s *SceneManager) Pop() {
+	if len(s.stack) > 1 {
+		s.stack = s.stack[:len(s.stack)-1]
+	}
+}
+
+// SwitchTo replaces every scene on the stack with a new scene. The new
+// scene is created in the middle of the transition when the old scenes
+// are covered. Switches during a running transition are ignored.
+func (s *SceneManager) SwitchTo(id SceneId, kind TransitionKind) {
+	if s.transition != nil {
+		return
+	}
+	s.transition = NewTransition(kind, config.SCENE_TRANSITION_TIME, func() {
+		s.stack = []stackEntry{s.newEntry(id)}
+	})
+}
+
+func (s *SceneManager) top() stackEntry {
+	return s.stack[len(s.stack)-1]
+}
+
+// Returns the scene with the given id if it is on the stack
+func (s *SceneManager) findScene(id SceneId) (Scene, bool) {
+	for _, entry := range s.stack {
+		if entry.id == id {
+			return entry.scene, true
+		}
+	}
+	return nil, false
+}
+
+// Overlays are removed when they end. When the scene at the bottom ends
+// the logical follow up scene is shown.
+func (s *SceneManager) endScene(entry stackEntry) {
+	if len(s.stack) > 1 {
+		s.Pop()
+		return
+	}
+	// If a game scene just ended this function will update the highscore
+	s.updateHighScore()
+	followUp, ok := followUpScenes[entry.id]
+	if !ok {
+		fmt.Println("Warning: Follow up -> MenuScene Could not determine follow up scene the scene:", entry.id)
+		followUp = MENU_SCENE
 	}
+	s.SwitchTo(followUp, sceneTransitions[followUp])
 }
 
 func (s *SceneManager) updateHighScore() {
-	if s.currentScene == GAME_SCENE {
-		scene := s.getCurrentScene()
-		if scoreScene, ok := scene.(gamescene.Score); ok {
-			newScore := scoreScene.GetScore()
-			s.stats.lastGameXPEarned = uint(newScore / 10000)
-			s.stats.playerXP += s.stats.lastGameXPEarned
-			s.stats.playerLevel = uint(s.stats.playerXP / 10)
-			s.stats.lastGameScore = newScore
-			if s.stats.highScore < newScore {
-				s.stats.highScore = newScore
-			}
+	scene, ok := s.findScene(GAME_SCENE)
+	if !ok {
+		return
+	}
+	if scoreScene, ok := scene.(gamescene.Score); ok {
+		newScore := scoreScene.GetScore()
+		s.stats.lastGameXPEarned = uint(newScore / 10000)
+		s.stats.playerXP += s.stats.lastGameXPEarned
+		s.stats.playerLevel = uint(s.stats.playerXP / 10)
+		s.stats.lastGameScore = newScore
+		if s.stats.highScore < newScore {
+			s.stats.highScore = newScore
+		}
+		s.stats.lastGamePlace = s.recordRun(scene, newScore)
+	}
+}
+
+// Adds the ended game to the leaderboard and returns its place
+func (s *SceneManager) recordRun(scene Scene, score int) (place int) {
+	run := leaderboard.Run{
+		Score: score,
+		Date:  time.Now(),
+	}
+	if provider, ok := scene.(gamescene.RunInfoProvider); ok {
+		info := provider.RunInfo()
+		run.SurvivalTime = info.Elapsed
+		run.Wave = info.Wave
+		run.MapKind = info.MapKind.String()
+		run.Seed = info.Seed
+		for _, weapon := range info.Weapons {
+			run.Weapons = append(run.Weapons, weapon.Name)
 		}
 	}
+	place = s.leaderboard.Add(run)
+	if err := s.leaderboard.Save(leaderboard.Path()); err != nil {
+		fmt.Println("Warning: Could not save the leaderboard:", err)
+	}
+	return place
 }
 
 func (s *SceneManager) Update() error {
@@ -124,28 +239,201 @@ func (s *SceneManager) Update() error {
 		return errors.New("Quitted Game")
 	}
 
-	scene := s.getCurrentScene()
-	if !scene.IsRunning() {
-		// If a game scene just ended this function will update the highscore
-		s.updateHighScore()
-		followUpScene := s.determineFollowUpScene()
-		s.setNextScene(followUpScene)
+	dt := 1.0 / float64(ebiten.TPS())
+	// The music fades independent of the current scene
+	assets.Audio.Update(dt)
+
+	if inpututil.IsKeyJustPressed(ebiten.KeyF3) {
+		s.showDebugOverlay = !s.showDebugOverlay
+	}
+
+	if s.transition != nil {
+		if s.transition.Update(dt) {
+			s.transition = nil
+		} else if !s.transition.Switched() {
+			// The old scene stands still while it gets covered
+			return nil
+		}
+	}
+
+	entry := s.top()
+	if !entry.scene.IsRunning() {
+		s.endScene(entry)
+		return nil
 	}
-	err := scene.Update()
-	return err
+	return entry.scene.Update()
 }
 
 func (s *SceneManager) Draw(screen *ebiten.Image) {
-	scene := s.getCurrentScene()
-	scene.Draw(screen)
+	// The canvas is recreated when a different resolution is chosen
+	width, height := display.Size()
+	if s.canvas == nil || s.canvas.Bounds().Dx() != width || s.canvas.Bounds().Dy() != height {
+		if s.canvas != nil {
+			s.canvas.Deallocate()
+		}
+		s.canvas = ebiten.NewImage(width, height)
+	}
+	s.canvas.Clear()
+
+	// Overlays are drawn on top of the scenes below them
+	first := len(s.stack) - 1
+	for first > 0 {
+		overlay, ok := s.stack[first].scene.(Overlay)
+		if !ok || !overlay.IsOverlay() {
+			break
+		}
+		first--
+	}
+	for _, entry := range s.stack[first:] {
+		entry.scene.Draw(s.canvas)
+	}
+	if s.transition != nil {
+		s.transition.Draw(s.canvas)
+	}
+
+	drawstats.EndFrame()
+	if s.showDebugOverlay {
+		s.drawDebugOverlay(s.canvas)
+	}
+	display.Present(screen, s.canvas)
+}
+
+// Shows how many sprites were drawn in the last frame and how many
+// atlas pages they come from. Fewer pages allow more batching.
+// Text, shapes and ui images are not part of the count.
+func (s *SceneManager) drawDebugOverlay(screen *ebiten.Image) {
+	text := fmt.Sprintf(
+		"Sprites drawn: %d\n(no text, shapes, ui)\nAtlas pages: %d\nFps: %d",
+		drawstats.LastFrame(),
+		assets.AssetStore.AtlasPages(),
+		int(ebiten.ActualFPS()),
+	)
+	width, _ := display.Size()
+	ebitenutil.DebugPrintAt(screen, text, width-150, 40)
 }
 
+// The game is drawn in its own resolution and scaled to the window in Draw
 func (s *SceneManager) Layout(outsideWidth, outsideHeight int) (screenWidth, screenHeight int) {
-	return config.SCREEN_WIDTH, config.SCREEN_HEIGHT
+	return display.Layout(outsideWidth, outsideHeight)
 }
 
 func (s *SceneManager) setExitGame() {
 	s.exitGame = true
 }
 
+// Shows the settings on top of the current scene
+func (s *SceneManager) openSettings() {
+	s.Push(SETTINGS_SCENE)
+}
+
+// Shows the best and the last runs on top of the current scene
+func (s *SceneManager) openLeaderboard() {
+	s.Push(LEADERBOARD_SCENE)
+}
+
+// Pauses the game by showing the pause overlay on top of it
+func (s *SceneManager) openPause() {
+	s.Push(PAUSE_SCENE)
+}
+
+// Returns the info of the running game. It is empty if no game is running.
+func (s *SceneManager) runInfo() gamescene.RunInfo {
+	scene, ok := s.findScene(GAME_SCENE)
+	if !ok {
+		return gamescene.RunInfo{}
+	}
+	if provider, ok := scene.(gamescene.RunInfoProvider); ok {
+		return provider.RunInfo()
+	}
+	return gamescene.RunInfo{}
+}
+
+// Asks with the confirm overlay before onConfirm is called
+func (s *SceneManager) confirm(question string, onConfirm func()) {
+	s.confirmQuestion = question
+	s.onConfirm = onConfirm
+	s.Push(CONFIRM_SCENE)
+}
+
+func (s *SceneManager) confirmExitToMenu() {
+	s.confirm("The current run will be lost.", s.exitToMenu)
+}
+
+func (s *SceneManager) confirmRestart() {
+	s.confirm("The run starts again on the same map.", s.restartRun)
+}
+
+// Ends the current run and starts a new one with the same map and seed
+func (s *SceneManager) restartRun() {
+	if s.transition != nil {
+		return
+	}
+	info := s.runInfo()
+	s.updateHighScore()
+	s.nextSeed = info.Seed
+	s.SwitchTo(GAME_SCENE, sceneTransitions[GAME_SCENE])
+}
+
+// Ends the current run and goes back to the menu
+func (s *SceneManager) exitToMenu() {
+	if s.transition != nil {
+		return
+	}
+	s.updateHighScore()
+	s.SwitchTo(MENU_SCENE, FadeTransition)
+}
+
+// Saves the running game and goes back to the menu. The run is not over
+// so the score does not count yet. If saving fails the game stays paused.
+func (s *SceneManager) saveAndQuit() {
+	if s.transition != nil {
+		return
+	}
+	scene, ok := s.findScene(GAME_SCENE)
+	if !ok {
+		return
+	}
+	saveable, ok := scene.(gamescene.Saveable)
+	if !ok {
+		return
+	}
+	if err := saveable.Snapshot().Save(savegame.Path()); err != nil {
+		fmt.Println("Warning: Could not save the run:", err)
+		toast.AddToast("The run could not be saved")
+		return
+	}
+	s.SwitchTo(MENU_SCENE, FadeTransition)
+}
+
+// Returns the action of the continue button in the menu.
+// It is nil if there is no saved run that can be continued.
+func (s *SceneManager) continueRunAction() func() {
+	path := savegame.Path()
+	if !savegame.Exists(path) {
+		return nil
+	}
+	snapshot, err := savegame.Load(path)
+	if err != nil {
+		fmt.Println("Warning: The saved run can not be continued:", err)
+		return nil
+	}
+	return func() { s.continueRun(snapshot) }
+}
+
+// Continues the saved run. The save is removed so every run can only be continued once.
+func (s *SceneManager) continueRun(snapshot *savegame.Snapshot) {
+	if s.transition != nil {
+		return
+	}
+	if err := savegame.Delete(savegame.Path()); err != nil {
+		fmt.Println("Warning:", err)
+	}
+	s.continuedRun = snapshot
+	s.SwitchTo(GAME_SCENE, sceneTransitions[GAME_SCENE])
+}
+
+func (s *SceneManager) setSelectedMap(mapKind gamescene.MapKind) {
+	s.selectedMap = mapKind
+}
+
 var _ ebiten.Game = (*SceneManager)(nil)
@@ -5,8 +5,8 @@ import (
 	"image/color"
 
 	"github.com/N3moAhead/harvest/internal/assets"
-	"github.com/N3moAhead/harvest/internal/config"
 	"github.com/N3moAhead/harvest/internal/hud"
+	"github.com/N3moAhead/harvest/internal/leaderboard"
 	"github.com/N3moAhead/harvest/pkg/ui"
 	"github.com/hajimehoshi/ebiten/v2"
 )
@@ -16,7 +16,10 @@ type ScoreScene struct {
 	uiManager *ui.UIManager
 }
 
-func NewScoreScene(stats PlayerStats) *ScoreScene {
+// The amount of best runs shown below the score
+const scoreSceneRuns = 5
+
+func NewScoreScene(stats PlayerStats, best []leaderboard.Run) *ScoreScene {
 
 	fontFace, ok := assets.AssetStore.GetFont("2p")
 	if !ok {
@@ -27,32 +30,20 @@ func NewScoreScene(stats PlayerStats) *ScoreScene {
 		panic("Unable to load font in score scene")
 	}
 
-	text := ui.NewLabel(50, 50, "You got Veggienated!", fontFace, color.RGBA{R: 255, G: 255, B: 255, A: 255})
-	// Center the text
-	textWidth, textHeight := text.GetSize()
-	offsetX := (config.SCREEN_WIDTH - textWidth) / 2
-	offsetY := (config.SCREEN_HEIGHT - textHeight) / 2
-	drawX := offsetX
-	drawY := offsetY - float64(text.Font.Metrics().Ascent/64)
-	text.SetPosition(drawX, drawY)
+	text := ui.NewLabel(0, 0, "You got Veggienated!", fontFace, color.RGBA{R: 255, G: 255, B: 255, A: 255})
 
-	newUiManager := ui.NewUIManager()
+	newUiManager := newUIManager()
 	newScoreScene := &ScoreScene{
 		BaseScene: *NewBaseScene(),
 		uiManager: newUiManager,
 	}
 
 	endSceneButton := ui.NewButton(0, 0, 400, 50, "Back to Menu", fontFace, func() { newScoreScene.SetIsRunning(false) })
-	btnWidth, btnHeight := endSceneButton.GetSize()
-	btnDrawX := (config.SCREEN_WIDTH - btnWidth) / 2
-	btnDrawY := (config.SCREEN_HEIGHT - btnHeight) / 2
-	btnDrawY += 75 // Move the button below the menu text
-	endSceneButton.SetPosition(btnDrawX, btnDrawY)
 
 	newScoreDisplay := hud.NewScoreDisplay(&stats.lastGameScore, "Score")
 
 	// Stats Display
-	statsContainer := ui.NewContainer(10, 10, &ui.ContainerOptions{
+	statsContainer := ui.NewContainer(0, 0, &ui.ContainerOptions{
 		Direction: ui.Col,
 		Gap:       10,
 	})
@@ -61,19 +52,33 @@ func NewScoreScene(stats PlayerStats) *ScoreScene {
 	//lastWave := ui.NewLabel(0, 0, fmt.Sprintf("Made it to wave: %d", uint(stats.currentWaveIndex/10)), microFont, color.White) // Could be cool
 	statsContainer.AddChild(xpEarnedDisplay)
 	statsContainer.AddChild(levelEarned)
-	newUiManager.AddElement(statsContainer)
-
-	newUiManager.AddElement(text)
-	newUiManager.AddElement(endSceneButton)
-	newUiManager.AddElement(newScoreDisplay)
-
-	sound, ok := assets.AssetStore.GetSFXData("veggienated")
-	if ok {
-		sfxPlayer := assets.AudioContext.NewPlayerFromBytes(sound)
-		sfxPlayer.Play()
-	} else {
-		fmt.Println("Warning: Could not load Veggienated sound")
+	newUiManager.AddAnchoredElement(statsContainer, ui.AnchorTopLeft, 10, 10)
+
+	newUiManager.AddAnchoredElement(text, ui.AnchorCenter, 0, 0)
+	// The button sits below the text
+	newUiManager.AddAnchoredElement(endSceneButton, ui.AnchorCenter, 0, 75)
+	newUiManager.AddAnchoredElement(newScoreDisplay, ui.AnchorTopRight, 0, 20)
+
+	// --- Leaderboard ---
+	// A run that placed is highlighted between the best runs
+	if stats.lastGamePlace > 0 {
+		placed := ui.NewLabel(0, 0, fmt.Sprintf("New #%d on the leaderboard!", stats.lastGamePlace), microFont, runPlaceColor)
+		newUiManager.AddAnchoredElement(placed, ui.AnchorCenter, 0, -45)
 	}
+	bestRuns := ui.NewContainer(0, 0, &ui.ContainerOptions{
+		Direction: ui.Col,
+		Gap:       4,
+	})
+	for i, run := range best[:min(len(best), scoreSceneRuns)] {
+		clr := color.Color(color.White)
+		if i+1 == stats.lastGamePlace {
+			clr = runPlaceColor
+		}
+		bestRuns.AddChild(newRunColumns(i+1, run, microFont, clr))
+	}
+	newUiManager.AddAnchoredElement(bestRuns, ui.AnchorBottom, 0, -10)
+
+	assets.PlaySound("veggienated")
 
 	return newScoreScene
 }
@@ -83,7 +88,7 @@ func (l *ScoreScene) Draw(screen *ebiten.Image) {
 }
 
 func (l *ScoreScene) Update() error {
-	l.uiManager.Update()
+	updateUI(l.uiManager)
 	return nil
 }
 
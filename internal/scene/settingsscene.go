@@ -0,0 +1,216 @@
+package scene
+
+import (
+	"fmt"
+	"image/color"
+
+	"github.com/N3moAhead/harvest/internal/assets"
+	"github.com/N3moAhead/harvest/internal/config"
+	"github.com/N3moAhead/harvest/internal/display"
+	"github.com/N3moAhead/harvest/internal/input"
+	"github.com/N3moAhead/harvest/internal/settings"
+	"github.com/N3moAhead/harvest/pkg/ui"
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/inpututil"
+)
+
+// The settings scene is an overlay that can be opened from the menu and
+// the pause overlay. Changes are applied right away and saved when leaving the scene.
+type SettingsScene struct {
+	BaseScene
+	uiManager   *ui.UIManager
+	settings    *settings.Settings
+	keyButtons  map[input.Action]*ui.Button
+	rebinding   input.Action // The action waiting for a new key. Empty if no action is waiting.
+	rebindTicks int          // Ticks left until the rebinding gets cancelled
+	pressedKeys []ebiten.Key
+}
+
+func NewSettingsScene() *SettingsScene {
+	fontFace, ok := assets.AssetStore.GetFont("2p")
+	if !ok {
+		panic("Unable to load font in settings scene")
+	}
+	microFont, ok := assets.AssetStore.GetFont("micro")
+	if !ok {
+		panic("Unable to load font in settings scene")
+	}
+
+	newUiManager := newUIManager()
+	newSettingsScene := &SettingsScene{
+		BaseScene:  *NewBaseScene(),
+		uiManager:  newUiManager,
+		settings:   settings.Current().Clone(),
+		keyButtons: make(map[input.Action]*ui.Button),
+	}
+	s := newSettingsScene.settings
+
+	title := ui.NewLabel(0, 0, "Settings", fontFace, color.White)
+	newUiManager.AddAnchoredElement(title, ui.AnchorTop, 0, 30)
+
+	// --- Audio & Display ---
+	rowWidth := 380.0
+	rowHeight := 28.0
+	optionsContainer := ui.NewContainer(0, 0, &ui.ContainerOptions{
+		Direction: ui.Col,
+		Gap:       10,
+	})
+	optionsContainer.AddChild(ui.NewSlider(0, 0, rowWidth, rowHeight, "Master Volume", microFont, 0, 1, s.MasterVolume, func(v float64) {
+		s.MasterVolume = v
+		newSettingsScene.apply()
+	}))
+	optionsContainer.AddChild(ui.NewSlider(0, 0, rowWidth, rowHeight, "Music Volume", microFont, 0, 1, s.MusicVolume, func(v float64) {
+		s.MusicVolume = v
+		newSettingsScene.apply()
+	}))
+	optionsContainer.AddChild(ui.NewSlider(0, 0, rowWidth, rowHeight, "SFX Volume", microFont, 0, 1, s.SFXVolume, func(v float64) {
+		s.SFXVolume = v
+		newSettingsScene.apply()
+	}))
+	optionsContainer.AddChild(ui.NewSlider(0, 0, rowWidth, rowHeight, "Screen Shake", microFont, 0, 1, s.ShakeIntensity, func(v float64) {
+		s.ShakeIntensity = v
+		newSettingsScene.apply()
+	}))
+	optionsContainer.AddChild(ui.NewToggle(0, 0, rowWidth, rowHeight, "Fullscreen", microFont, s.Fullscreen, func(v bool) {
+		s.Fullscreen = v
+		newSettingsScene.apply()
+	}))
+	optionsContainer.AddChild(ui.NewToggle(0, 0, rowWidth, rowHeight, "VSync", microFont, s.VSync, func(v bool) {
+		s.VSync = v
+		newSettingsScene.apply()
+	}))
+	// Cycles through the resolutions
+	var resolutionBtn *ui.Button
+	resolutionBtn = ui.NewButton(0, 0, rowWidth, rowHeight, resolutionButtonText(s.Resolution), microFont, func() {
+		s.Resolution = nextResolution(s.Resolution).Name
+		resolutionBtn.Text = resolutionButtonText(s.Resolution)
+		newSettingsScene.apply()
+	})
+	optionsContainer.AddChild(resolutionBtn)
+	optionsContainer.AddChild(ui.NewToggle(0, 0, rowWidth, rowHeight, "Pixel Perfect", microFont, s.PixelPerfect, func(v bool) {
+		s.PixelPerfect = v
+		newSettingsScene.apply()
+	}))
+	newUiManager.AddAnchoredElement(optionsContainer, ui.AnchorTopLeft, 40, 90)
+
+	// --- Keybinds ---
+	// Every action has a key and a gamepad button. The gap is smaller so all actions fit above the back button.
+	keysContainer := ui.NewContainer(0, 0, &ui.ContainerOptions{
+		Direction: ui.Col,
+		Gap:       4,
+	})
+	for _, action := range input.Actions {
+		keyBtn := ui.NewButton(0, 0, rowWidth, rowHeight, "", microFont, func() { newSettingsScene.startRebinding(action) })
+		newSettingsScene.keyButtons[action] = keyBtn
+		keysContainer.AddChild(keyBtn)
+	}
+	resetBtn := ui.NewButton(0, 0, rowWidth, rowHeight, "Reset Keys", microFont, func() {
+		s.Keybinds = input.DefaultKeybinds()
+		s.GamepadBinds = input.DefaultGamepadBinds()
+		newSettingsScene.rebinding = ""
+		newSettingsScene.apply()
+	})
+	keysContainer.AddChild(resetBtn)
+	newUiManager.AddAnchoredElement(keysContainer, ui.AnchorTopRight, -40, 90)
+	newSettingsScene.updateKeyButtons()
+
+	backBtn := ui.NewButton(0, 0, 200, 40, "Back", fontFace, newSettingsScene.close)
+	newUiManager.AddAnchoredElement(backBtn, ui.AnchorBottom, 0, -30)
+
+	return newSettingsScene
+}
+
+func resolutionButtonText(name string) string {
+	resolution, _ := display.FindResolution(name)
+	return fmt.Sprintf("Resolution: %s (%dx%d)", resolution.Name, resolution.Width, resolution.Height)
+}
+
+// Returns the resolution after the one with the given name
+func nextResolution(name string) display.Resolution {
+	for i, resolution := range display.Resolutions {
+		if resolution.Name == name {
+			return display.Resolutions[(i+1)%len(display.Resolutions)]
+		}
+	}
+	return display.DefaultResolution()
+}
+
+// Makes the changed settings the current ones
+func (s *SettingsScene) apply() {
+	settings.SetCurrent(s.settings)
+	s.settings.Apply()
+	assets.ApplyVolumes()
+	s.updateKeyButtons()
+}
+
+func (s *SettingsScene) startRebinding(action input.Action) {
+	s.rebinding = action
+	s.rebindTicks = config.REBIND_TIMEOUT
+	s.updateKeyButtons()
+}
+
+func (s *SettingsScene) stopRebinding() {
+	s.rebinding = ""
+	s.apply()
+}
+
+func (s *SettingsScene) updateKeyButtons() {
+	for action, btn := range s.keyButtons {
+		if action == s.rebinding {
+			secondsLeft := (s.rebindTicks + ebiten.TPS() - 1) / ebiten.TPS()
+			btn.Text = fmt.Sprintf("%s: Press a key or button (%ds)", action, secondsLeft)
+		} else {
+			btn.Text = fmt.Sprintf("%s: %s / %s", action, s.settings.Keybinds[action], s.settings.GamepadBinds[action])
+		}
+	}
+}
+
+// Saves the settings and goes back to the scene the settings were opened from.
+// The scene manager removes the overlay once it stopped running.
+func (s *SettingsScene) close() {
+	s.rebinding = ""
+	s.apply()
+	if err := s.settings.Save(settings.Path()); err != nil {
+		fmt.Println("Warning: Could not save settings:", err)
+	}
+	s.SetIsRunning(false)
+}
+
+func (s *SettingsScene) Update() error {
+	// While an action waits for a key the next pressed key or gamepad
+	// button is used for it. Every key can be bound, Escape included, so
+	// the rebinding is cancelled by a mouse click or after a timeout.
+	// The ui skips that tick so the pressed key does not move the focus as well.
+	if s.rebinding != "" {
+		s.rebindTicks--
+		s.pressedKeys = inpututil.AppendJustPressedKeys(s.pressedKeys[:0])
+		if len(s.pressedKeys) > 0 {
+			s.settings.Keybinds[s.rebinding] = s.pressedKeys[0]
+			s.stopRebinding()
+		} else if button, ok := input.JustPressedGamepadButton(); ok {
+			s.settings.GamepadBinds[s.rebinding] = button
+			s.stopRebinding()
+		} else if s.rebindTicks <= 0 || inpututil.IsMouseButtonJustPressed(ebiten.MouseButtonLeft) {
+			s.stopRebinding()
+		} else {
+			s.updateKeyButtons()
+		}
+		return nil
+	} else if input.IsActionJustPressed(input.ActionCancel) {
+		s.close()
+		return nil
+	}
+	updateUI(s.uiManager)
+	return nil
+}
+
+func (s *SettingsScene) Draw(screen *ebiten.Image) {
+	drawOverlayBackground(screen)
+	s.uiManager.Draw(screen)
+}
+
+func (s *SettingsScene) IsOverlay() bool {
+	return true
+}
+
+var _ Overlay = (*SettingsScene)(nil)
@@ -0,0 +1,87 @@
+package scene
+
+import (
+	"image/color"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/vector"
+)
+
+type TransitionKind int
+
+const (
+	NoTransition TransitionKind = iota
+	FadeTransition
+	WipeTransition
+)
+
+var transitionColor = color.RGBA{R: 0, G: 0, B: 0, A: 255}
+
+// Transition covers the old scene, switches the scene in the middle
+// and uncovers the new scene afterwards. The duration is in seconds.
+type Transition struct {
+	kind     TransitionKind
+	duration float64
+	elapsed  float64
+	onSwitch func()
+	switched bool
+}
+
+func NewTransition(kind TransitionKind, duration float64, onSwitch func()) *Transition {
+	return &Transition{
+		kind:     kind,
+		duration: duration,
+		onSwitch: onSwitch,
+	}
+}
+
+// Update advances the transition and returns true when it is finished.
+// Transitions without a duration switch right away.
+func (t *Transition) Update(dt float64) bool {
+	t.elapsed += dt
+	if !t.switched && (t.elapsed >= t.duration/2 || t.kind == NoTransition) {
+		t.switched = true
+		t.onSwitch()
+	}
+	return t.switched && (t.elapsed >= t.duration || t.kind == NoTransition)
+}
+
+// Switched returns true once the new scene is shown
+func (t *Transition) Switched() bool {
+	return t.switched
+}
+
+// Coverage returns how much of the screen is covered between 0 and 1
+func (t *Transition) Coverage() float64 {
+	if t.duration <= 0 || t.kind == NoTransition {
+		return 0
+	}
+	half := t.duration / 2
+	if !t.switched {
+		return min(1, t.elapsed/half)
+	}
+	return min(1, max(0, 1-(t.elapsed-half)/half))
+}
+
+func (t *Transition) Draw(screen *ebiten.Image) {
+	coverage := t.Coverage()
+	if coverage <= 0 {
+		return
+	}
+	width := float32(screen.Bounds().Dx())
+	height := float32(screen.Bounds().Dy())
+	switch t.kind {
+	case FadeTransition:
+		fade := transitionColor
+		fade.A = uint8(coverage * 255)
+		vector.DrawFilledRect(screen, 0, 0, width, height, fade, false)
+	case WipeTransition:
+		// The bar comes in from the left and leaves to the right
+		barWidth := width * float32(coverage)
+		x := float32(0)
+		if t.switched {
+			x = width - barWidth
+		}
+		vector.DrawFilledRect(screen, x, 0, barWidth, height, transitionColor, false)
+	}
+}
@@ -0,0 +1,144 @@
+package settings
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"github.com/N3moAhead/harvest/internal/config"
+	"github.com/N3moAhead/harvest/internal/display"
+	"github.com/N3moAhead/harvest/internal/input"
+	"github.com/N3moAhead/harvest/pkg/util"
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+// Settings are the options the player can change in the settings scene.
+// They are stored as json in the config directory of the user.
+type Settings struct {
+	MasterVolume   float64            `json:"master_volume"` // All volumes are between 0 and 1
+	MusicVolume    float64            `json:"music_volume"`
+	SFXVolume      float64            `json:"sfx_volume"`
+	Fullscreen     bool               `json:"fullscreen"`
+	VSync          bool               `json:"vsync"`
+	Resolution     string             `json:"resolution"`      // The name of a display.Resolution
+	PixelPerfect   bool               `json:"pixel_perfect"`   // Only scale the game by whole numbers
+	ShakeIntensity float64            `json:"shake_intensity"` // 0 turns the screen shake off
+	Keybinds       input.Keybinds     `json:"keybinds"`
+	GamepadBinds   input.GamepadBinds `json:"gamepad_binds"`
+}
+
+func Default() *Settings {
+	return &Settings{
+		MasterVolume:   1,
+		MusicVolume:    0.8,
+		SFXVolume:      1,
+		Fullscreen:     true,
+		VSync:          true,
+		Resolution:     display.DefaultResolution().Name,
+		PixelPerfect:   true,
+		ShakeIntensity: 1,
+		Keybinds:       input.DefaultKeybinds(),
+		GamepadBinds:   input.DefaultGamepadBinds(),
+	}
+}
+
+// The settings that are currently used by the game
+var current = Default()
+
+func Current() *Settings {
+	return current
+}
+
+func SetCurrent(s *Settings) {
+	current = s
+}
+
+// Path returns the location of the settings file
+func Path() string {
+	return config.FilePath(config.SETTINGS_FILE_NAME)
+}
+
+// Load reads the settings from the file. A missing file is not an error,
+// the default settings are used instead. Values missing in the file keep
+// their default value. On errors the default settings are returned too.
+func Load(path string) (*Settings, error) {
+	s := Default()
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return s, nil
+	}
+	if err != nil {
+		return s, fmt.Errorf("failed to read settings %s: %w", path, err)
+	}
+	if err := json.Unmarshal(data, s); err != nil {
+		return Default(), fmt.Errorf("failed to parse settings %s: %w", path, err)
+	}
+	s.sanitize()
+	return s, nil
+}
+
+// Save writes the settings to the file and creates its directory if needed
+func (s *Settings) Save(path string) error {
+	data, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("failed to create the settings directory: %w", err)
+	}
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		return fmt.Errorf("failed to write settings %s: %w", path, err)
+	}
+	return nil
+}
+
+// Clone returns a copy that can be changed without changing the original
+func (s *Settings) Clone() *Settings {
+	clone := *s
+	clone.Keybinds = s.Keybinds.Clone()
+	clone.GamepadBinds = s.GamepadBinds.Clone()
+	return &clone
+}
+
+// Keeps hand edited files from breaking the game
+func (s *Settings) sanitize() {
+	s.MasterVolume = util.Clamp(s.MasterVolume, 0, 1)
+	s.MusicVolume = util.Clamp(s.MusicVolume, 0, 1)
+	s.SFXVolume = util.Clamp(s.SFXVolume, 0, 1)
+	s.ShakeIntensity = util.Clamp(s.ShakeIntensity, 0, 1)
+	if _, ok := display.FindResolution(s.Resolution); !ok {
+		s.Resolution = display.DefaultResolution().Name
+	}
+	keybinds := input.DefaultKeybinds()
+	for action, key := range s.Keybinds {
+		if _, ok := keybinds[action]; ok {
+			keybinds[action] = key
+		}
+	}
+	s.Keybinds = keybinds
+	gamepadBinds := input.DefaultGamepadBinds()
+	for action, button := range s.GamepadBinds {
+		if _, ok := gamepadBinds[action]; ok {
+			gamepadBinds[action] = button
+		}
+	}
+	s.GamepadBinds = gamepadBinds
+}
+
+// Apply changes the window, the resolution, the keybinds and the gamepad binds
+// to the settings. The volumes are applied to the audio buses by assets.ApplyVolumes.
+func (s *Settings) Apply() {
+	ebiten.SetFullscreen(s.Fullscreen)
+	ebiten.SetVsyncEnabled(s.VSync)
+	resolution, ok := display.FindResolution(s.Resolution)
+	if !ok {
+		resolution = display.DefaultResolution()
+	}
+	display.SetResolution(resolution)
+	display.SetPixelPerfect(s.PixelPerfect)
+	input.SetKeybinds(s.Keybinds)
+	input.SetGamepadBinds(s.GamepadBinds)
+}
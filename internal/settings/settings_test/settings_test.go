@@ -0,0 +1,140 @@
+package settings_test
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/N3moAhead/harvest/internal/display"
+	"github.com/N3moAhead/harvest/internal/input"
+	"github.com/N3moAhead/harvest/internal/settings"
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+// Writes the default settings with the changes as a hand edited file would look like
+func writeSettings(t *testing.T, change func(file map[string]any)) string {
+	t.Helper()
+	data, err := json.Marshal(settings.Default())
+	if err != nil {
+		t.Fatal(err)
+	}
+	file := make(map[string]any)
+	if err := json.Unmarshal(data, &file); err != nil {
+		t.Fatal(err)
+	}
+	change(file)
+	if data, err = json.Marshal(file); err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(t.TempDir(), "settings.json")
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestLoadMissingFileUsesTheDefaults(t *testing.T) {
+	s, err := settings.Load(filepath.Join(t.TempDir(), "missing.json"))
+	if err != nil {
+		t.Fatalf("Expected a missing file to not be an error, got %v", err)
+	}
+	if s.MusicVolume != settings.Default().MusicVolume || s.Keybinds[input.ActionUp] != input.DefaultKeybinds()[input.ActionUp] {
+		t.Errorf("Expected the default settings, got %+v", s)
+	}
+}
+
+func TestLoadBrokenFileUsesTheDefaults(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "settings.json")
+	if err := os.WriteFile(path, []byte("{not json"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	s, err := settings.Load(path)
+	if err == nil {
+		t.Error("Expected an error for a broken file")
+	}
+	if s == nil || s.MasterVolume != settings.Default().MasterVolume {
+		t.Errorf("Expected the default settings, got %+v", s)
+	}
+}
+
+func TestLoadClampsValues(t *testing.T) {
+	path := writeSettings(t, func(file map[string]any) {
+		file["master_volume"] = 3
+		file["music_volume"] = -1
+		file["sfx_volume"] = 0.5
+		file["shake_intensity"] = 10
+		file["resolution"] = "4:3"
+	})
+	s, err := settings.Load(path)
+	if err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+	if s.MasterVolume != 1 || s.MusicVolume != 0 || s.SFXVolume != 0.5 || s.ShakeIntensity != 1 {
+		t.Errorf("Expected the values to be clamped, got %+v", s)
+	}
+	if s.Resolution != display.DefaultResolution().Name {
+		t.Errorf("Expected an unknown resolution to fall back to %s, got %s", display.DefaultResolution().Name, s.Resolution)
+	}
+}
+
+func TestLoadKeepsOnlyKnownActions(t *testing.T) {
+	path := writeSettings(t, func(file map[string]any) {
+		file["keybinds"] = map[string]any{
+			string(input.ActionUp): ebiten.KeyI.String(),
+			"fly":                  ebiten.KeyF.String(),
+		}
+		file["gamepad_binds"] = map[string]any{"fly": "A"}
+	})
+	s, err := settings.Load(path)
+	if err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+	if s.Keybinds[input.ActionUp] != ebiten.KeyI {
+		t.Errorf("Expected up to be bound to I, got %v", s.Keybinds[input.ActionUp])
+	}
+	if _, ok := s.Keybinds["fly"]; ok {
+		t.Error("Expected the unknown action to be dropped from the keybinds")
+	}
+	if _, ok := s.GamepadBinds["fly"]; ok {
+		t.Error("Expected the unknown action to be dropped from the gamepad binds")
+	}
+	// Actions missing in the file keep their default
+	for _, action := range input.Actions {
+		if _, ok := s.Keybinds[action]; !ok {
+			t.Errorf("Expected %s to have a key", action)
+		}
+		if _, ok := s.GamepadBinds[action]; !ok {
+			t.Errorf("Expected %s to have a gamepad button", action)
+		}
+	}
+}
+
+func TestSaveAndLoad(t *testing.T) {
+	// Save creates the directory of the file
+	path := filepath.Join(t.TempDir(), "harvest", "settings.json")
+	s := settings.Default()
+	s.SFXVolume = 0.25
+	s.Fullscreen = false
+	s.Keybinds[input.ActionPause] = ebiten.KeyP
+	if err := s.Save(path); err != nil {
+		t.Fatalf("Save failed: %v", err)
+	}
+
+	loaded, err := settings.Load(path)
+	if err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+	if loaded.SFXVolume != 0.25 || loaded.Fullscreen || loaded.Keybinds[input.ActionPause] != ebiten.KeyP {
+		t.Errorf("Expected the saved settings, got %+v", loaded)
+	}
+}
+
+func TestCloneDoesNotShareTheBinds(t *testing.T) {
+	s := settings.Default()
+	clone := s.Clone()
+	clone.Keybinds[input.ActionUp] = ebiten.KeyI
+	if s.Keybinds[input.ActionUp] == ebiten.KeyI {
+		t.Error("Expected changing the clone to leave the original unchanged")
+	}
+}
@@ -0,0 +1,238 @@
+package tiled
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"sort"
+)
+
+// The structure of maps and tilesets stored as tmj/json by tiled
+
+type jsonProperty struct {
+	Name  string `json:"name"`
+	Type  string `json:"type"`
+	Value any    `json:"value"`
+}
+
+type jsonObject struct {
+	ID         int            `json:"id"`
+	Name       string         `json:"name"`
+	Type       string         `json:"type"`
+	Class      string         `json:"class"`
+	X          float64        `json:"x"`
+	Y          float64        `json:"y"`
+	Width      float64        `json:"width"`
+	Height     float64        `json:"height"`
+	Point      bool           `json:"point"`
+	Properties []jsonProperty `json:"properties"`
+}
+
+type jsonLayer struct {
+	Name        string          `json:"name"`
+	Type        string          `json:"type"`
+	Visible     *bool           `json:"visible"`
+	OffsetX     float64         `json:"offsetx"`
+	OffsetY     float64         `json:"offsety"`
+	Data        json.RawMessage `json:"data"`
+	Encoding    string          `json:"encoding"`
+	Compression string          `json:"compression"`
+	Objects     []jsonObject    `json:"objects"`
+	Layers      []jsonLayer     `json:"layers"`
+	Properties  []jsonProperty  `json:"properties"`
+}
+
+type jsonTile struct {
+	ID         uint32         `json:"id"`
+	Properties []jsonProperty `json:"properties"`
+}
+
+type jsonTileset struct {
+	FirstGID   uint32     `json:"firstgid"`
+	Source     string     `json:"source"`
+	Name       string     `json:"name"`
+	Image      string     `json:"image"`
+	TileWidth  int        `json:"tilewidth"`
+	TileHeight int        `json:"tileheight"`
+	Columns    int        `json:"columns"`
+	TileCount  int        `json:"tilecount"`
+	Margin     int        `json:"margin"`
+	Spacing    int        `json:"spacing"`
+	Tiles      []jsonTile `json:"tiles"`
+}
+
+type jsonMap struct {
+	Width      int            `json:"width"`
+	Height     int            `json:"height"`
+	TileWidth  int            `json:"tilewidth"`
+	TileHeight int            `json:"tileheight"`
+	Infinite   bool           `json:"infinite"`
+	Layers     []jsonLayer    `json:"layers"`
+	Tilesets   []jsonTileset  `json:"tilesets"`
+	Properties []jsonProperty `json:"properties"`
+}
+
+func loadJSON(fsys fs.FS, path string) (*Map, error) {
+	data, err := fs.ReadFile(fsys, path)
+	if err != nil {
+		return nil, err
+	}
+	var raw jsonMap
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, err
+	}
+	if raw.Infinite {
+		return nil, fmt.Errorf("infinite maps are not supported")
+	}
+
+	m := &Map{
+		Width:      raw.Width,
+		Height:     raw.Height,
+		TileWidth:  raw.TileWidth,
+		TileHeight: raw.TileHeight,
+		Properties: convertJSONProperties(raw.Properties),
+	}
+
+	for _, rawTileset := range raw.Tilesets {
+		tileset, err := convertJSONTileset(fsys, path, rawTileset)
+		if err != nil {
+			return nil, err
+		}
+		m.Tilesets = append(m.Tilesets, tileset)
+	}
+	sort.Slice(m.Tilesets, func(i, j int) bool { return m.Tilesets[i].FirstGID < m.Tilesets[j].FirstGID })
+
+	layers, err := convertJSONLayers(raw.Layers, rootGroup)
+	if err != nil {
+		return nil, err
+	}
+	m.Layers = layers
+	return m, nil
+}
+
+// Tilesets can be embedded into the map or stored in their own file
+func convertJSONTileset(fsys fs.FS, mapPath string, raw jsonTileset) (*Tileset, error) {
+	imageBase := mapPath
+	if raw.Source != "" {
+		source := resolvePath(mapPath, raw.Source)
+		if ext := extension(source); ext == ".tsx" {
+			tileset, err := loadTSX(fsys, source)
+			if err != nil {
+				return nil, err
+			}
+			tileset.FirstGID = raw.FirstGID
+			return tileset, nil
+		}
+		data, err := fs.ReadFile(fsys, source)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load tileset %s: %w", source, err)
+		}
+		firstGID := raw.FirstGID
+		if err := json.Unmarshal(data, &raw); err != nil {
+			return nil, fmt.Errorf("failed to load tileset %s: %w", source, err)
+		}
+		raw.FirstGID = firstGID
+		imageBase = source
+	}
+
+	tileset := &Tileset{
+		FirstGID:       raw.FirstGID,
+		Name:           raw.Name,
+		Image:          resolvePath(imageBase, raw.Image),
+		TileWidth:      raw.TileWidth,
+		TileHeight:     raw.TileHeight,
+		Columns:        raw.Columns,
+		TileCount:      raw.TileCount,
+		Margin:         raw.Margin,
+		Spacing:        raw.Spacing,
+		TileProperties: make(map[uint32]Properties),
+	}
+	for _, tile := range raw.Tiles {
+		tileset.TileProperties[tile.ID] = convertJSONProperties(tile.Properties)
+	}
+	return tileset, nil
+}
+
+func convertJSONLayers(rawLayers []jsonLayer, group groupState) ([]*Layer, error) {
+	var layers []*Layer
+	for _, raw := range rawLayers {
+		state := group.child(raw.Visible == nil || *raw.Visible, raw.OffsetX, raw.OffsetY)
+		switch raw.Type {
+		case "tilelayer":
+			tiles, err := decodeJSONTileData(raw)
+			if err != nil {
+				return nil, fmt.Errorf("layer '%s': %w", raw.Name, err)
+			}
+			layers = append(layers, &Layer{
+				Name:       raw.Name,
+				Type:       TileLayer,
+				Visible:    state.visible,
+				OffsetX:    state.offsetX,
+				OffsetY:    state.offsetY,
+				Tiles:      tiles,
+				Properties: convertJSONProperties(raw.Properties),
+			})
+		case "objectgroup":
+			layer := &Layer{
+				Name:       raw.Name,
+				Type:       ObjectLayer,
+				Visible:    state.visible,
+				OffsetX:    state.offsetX,
+				OffsetY:    state.offsetY,
+				Properties: convertJSONProperties(raw.Properties),
+			}
+			for _, obj := range raw.Objects {
+				class := obj.Class
+				if class == "" {
+					class = obj.Type
+				}
+				layer.Objects = append(layer.Objects, Object{
+					ID:         obj.ID,
+					Name:       obj.Name,
+					Class:      class,
+					X:          obj.X,
+					Y:          obj.Y,
+					Width:      obj.Width,
+					Height:     obj.Height,
+					Point:      obj.Point,
+					Properties: convertJSONProperties(obj.Properties),
+				})
+			}
+			layers = append(layers, layer)
+		case "group":
+			children, err := convertJSONLayers(raw.Layers, state)
+			if err != nil {
+				return nil, err
+			}
+			layers = append(layers, children...)
+		default:
+			// Image layers are not needed for the game
+			continue
+		}
+	}
+	return layers, nil
+}
+
+// The tile data is either a plain json array or an encoded string
+func decodeJSONTileData(raw jsonLayer) ([]uint32, error) {
+	if raw.Encoding == "base64" {
+		var encoded string
+		if err := json.Unmarshal(raw.Data, &encoded); err != nil {
+			return nil, err
+		}
+		return decodeBase64Tiles(encoded, raw.Compression)
+	}
+	var tiles []uint32
+	if err := json.Unmarshal(raw.Data, &tiles); err != nil {
+		return nil, err
+	}
+	return tiles, nil
+}
+
+func convertJSONProperties(raw []jsonProperty) Properties {
+	properties := make(Properties, len(raw))
+	for _, property := range raw {
+		properties[property.Name] = fmt.Sprint(property.Value)
+	}
+	return properties
+}
@@ -0,0 +1,204 @@
+package tiled
+
+import (
+	"fmt"
+	"image"
+	"io/fs"
+	"path"
+	"strconv"
+	"strings"
+)
+
+// The highest bits of a gid are used by tiled to store if the tile is flipped
+const (
+	flippedHorizontally = 0x80000000
+	flippedVertically   = 0x40000000
+	flippedDiagonally   = 0x20000000
+	rotatedHexagonal    = 0x10000000
+	gidMask             = ^uint32(flippedHorizontally | flippedVertically | flippedDiagonally | rotatedHexagonal)
+)
+
+// Custom properties set in the tiled editor. All values are stored as strings.
+type Properties map[string]string
+
+// Bool returns the property as a bool. Missing or invalid values are false.
+func (p Properties) Bool(name string) bool {
+	value, ok := p[name]
+	if !ok {
+		return false
+	}
+	b, err := strconv.ParseBool(value)
+	return err == nil && b
+}
+
+type LayerType int
+
+const (
+	TileLayer LayerType = iota
+	ObjectLayer
+)
+
+type Layer struct {
+	Name       string
+	Type       LayerType
+	Visible    bool    // False if the layer or one of its groups is hidden
+	OffsetX    float64 // The offset in pixels including the offsets of its groups
+	OffsetY    float64
+	Tiles      []uint32 // The gids of a tile layer row by row. 0 means there is no tile.
+	Objects    []Object // The objects of an object layer
+	Properties Properties
+}
+
+// What a group passes on to its layers
+type groupState struct {
+	visible          bool
+	offsetX, offsetY float64
+}
+
+// The groups of the map are visible and not moved
+var rootGroup = groupState{visible: true}
+
+// Combines the group with the visibility and offset of one of its layers or groups
+func (g groupState) child(visible bool, offsetX, offsetY float64) groupState {
+	return groupState{
+		visible: g.visible && visible,
+		offsetX: g.offsetX + offsetX,
+		offsetY: g.offsetY + offsetY,
+	}
+}
+
+type Object struct {
+	ID         int
+	Name       string
+	Class      string // Called "type" in tiled versions before 1.9
+	X, Y       float64
+	Width      float64
+	Height     float64
+	Point      bool
+	Properties Properties
+}
+
+type Tileset struct {
+	FirstGID       uint32
+	Name           string
+	Image          string // The path to the image of the tileset
+	TileWidth      int
+	TileHeight     int
+	Columns        int
+	TileCount      int
+	Margin         int
+	Spacing        int
+	TileProperties map[uint32]Properties // Properties by the local id of a tile
+}
+
+// TileRect returns the area of the tile with the local id on the tileset image.
+// The local id has to be smaller than the tile count, TilesetFor only returns such ids.
+func (t *Tileset) TileRect(localID uint32) image.Rectangle {
+	col := int(localID) % t.Columns
+	row := int(localID) / t.Columns
+	x := t.Margin + col*(t.TileWidth+t.Spacing)
+	y := t.Margin + row*(t.TileHeight+t.Spacing)
+	return image.Rect(x, y, x+t.TileWidth, y+t.TileHeight)
+}
+
+type Map struct {
+	Width      int // The width in tiles
+	Height     int // The height in tiles
+	TileWidth  int
+	TileHeight int
+	Tilesets   []*Tileset // Sorted by their first gid
+	Layers     []*Layer   // Layers of groups get flattened into this list
+	Properties Properties
+}
+
+// TilesetFor returns the tileset the gid belongs to and the local id of the tile in it.
+// ok is false if the gid is empty or larger than the last tile of its tileset.
+func (m *Map) TilesetFor(gid uint32) (tileset *Tileset, localID uint32, ok bool) {
+	gid &= gidMask
+	if gid == 0 {
+		return nil, 0, false
+	}
+	for i := len(m.Tilesets) - 1; i >= 0; i-- {
+		tileset := m.Tilesets[i]
+		if tileset.FirstGID <= gid {
+			localID := gid - tileset.FirstGID
+			if localID >= uint32(tileset.TileCount) {
+				return nil, 0, false
+			}
+			return tileset, localID, true
+		}
+	}
+	return nil, 0, false
+}
+
+// TileProperties returns the properties set on the tile with the given gid
+func (m *Map) TileProperties(gid uint32) Properties {
+	tileset, localID, ok := m.TilesetFor(gid)
+	if !ok {
+		return nil
+	}
+	return tileset.TileProperties[localID]
+}
+
+// LayerByName finds a layer by its name. The case of the name is ignored.
+func (m *Map) LayerByName(name string) (*Layer, bool) {
+	for _, layer := range m.Layers {
+		if strings.EqualFold(layer.Name, name) {
+			return layer, true
+		}
+	}
+	return nil, false
+}
+
+// Load reads a map saved by the tiled editor from the file system.
+// Maps can be stored as tmx (xml) or tmj/json. External tilesets are
+// loaded as well. Paths of tilesets and images are relative to the
+// root of the file system. Infinite maps are not supported.
+func Load(fsys fs.FS, path string) (*Map, error) {
+	var m *Map
+	var err error
+	switch extension(path) {
+	case ".tmx":
+		m, err = loadTMX(fsys, path)
+	case ".tmj", ".json":
+		m, err = loadJSON(fsys, path)
+	default:
+		return nil, fmt.Errorf("unsupported map format: %s", path)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to load map %s: %w", path, err)
+	}
+	if err := m.validate(); err != nil {
+		return nil, fmt.Errorf("invalid map %s: %w", path, err)
+	}
+	return m, nil
+}
+
+func (m *Map) validate() error {
+	if m.Width <= 0 || m.Height <= 0 {
+		return fmt.Errorf("map size %dx%d is invalid", m.Width, m.Height)
+	}
+	for _, tileset := range m.Tilesets {
+		if tileset.Columns <= 0 || tileset.TileCount <= 0 || tileset.TileWidth <= 0 || tileset.TileHeight <= 0 {
+			return fmt.Errorf("tileset '%s' has no valid tile size, columns or tile count", tileset.Name)
+		}
+	}
+	for _, layer := range m.Layers {
+		if layer.Type == TileLayer && len(layer.Tiles) != m.Width*m.Height {
+			return fmt.Errorf("layer '%s' has %d tiles but the map has %d", layer.Name, len(layer.Tiles), m.Width*m.Height)
+		}
+	}
+	return nil
+}
+
+// Resolves a path of a map or tileset file that is relative to the file itself
+func resolvePath(fromFile, relative string) string {
+	if relative == "" {
+		return relative
+	}
+	return path.Join(path.Dir(fromFile), relative)
+}
+
+func extension(file string) string {
+	return strings.ToLower(path.Ext(file))
+}
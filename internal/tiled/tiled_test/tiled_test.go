@@ -0,0 +1,183 @@
+package tiled_test
+
+import (
+	"os"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/N3moAhead/harvest/internal/tiled"
+)
+
+// All fixture maps are 2x2 tiles with the same ground layer. The third
+// tile is flipped horizontally, so its gid has the highest bit set.
+var groundTiles = []uint32{1, 2, 0x80000003, 0}
+
+var fixtures = os.DirFS("testdata")
+
+func TestLoadEncodings(t *testing.T) {
+	for _, path := range []string{"csv.tmx", "base64.tmx", "zlib.tmx", "gzip.tmx", "map.tmj", "zlib.tmj"} {
+		m, err := tiled.Load(fixtures, path)
+		if err != nil {
+			t.Errorf("%s: Load failed: %v", path, err)
+			continue
+		}
+		if m.Width != 2 || m.Height != 2 || m.TileWidth != 16 || m.TileHeight != 16 {
+			t.Errorf("%s: unexpected map size %dx%d with tiles of %dx%d", path, m.Width, m.Height, m.TileWidth, m.TileHeight)
+		}
+		ground, ok := m.LayerByName("Ground")
+		if !ok {
+			t.Errorf("%s: expected a ground layer", path)
+			continue
+		}
+		if !slices.Equal(ground.Tiles, groundTiles) {
+			t.Errorf("%s: expected the tiles %v, got %v", path, groundTiles, ground.Tiles)
+		}
+		if m.Properties["name"] != "Test Arena" {
+			t.Errorf("%s: expected the map name property, got %q", path, m.Properties["name"])
+		}
+	}
+}
+
+func TestLoadExternalTileset(t *testing.T) {
+	for _, path := range []string{"external.tmx", "map.tmj"} {
+		m, err := tiled.Load(fixtures, path)
+		if err != nil {
+			t.Fatalf("%s: Load failed: %v", path, err)
+		}
+		if len(m.Tilesets) != 1 {
+			t.Fatalf("%s: expected 1 tileset, got %d", path, len(m.Tilesets))
+		}
+		tileset := m.Tilesets[0]
+		if tileset.Name != "terrain" || tileset.FirstGID != 1 || tileset.TileCount != 4 || tileset.Columns != 2 {
+			t.Errorf("%s: the tileset was not loaded: %+v", path, tileset)
+		}
+		// The image path is relative to the tsx file
+		if tileset.Image != "tilesets/terrain.png" {
+			t.Errorf("%s: expected the image tilesets/terrain.png, got %s", path, tileset.Image)
+		}
+		if !m.TileProperties(2).Bool("solid") || m.TileProperties(1).Bool("solid") {
+			t.Errorf("%s: expected only the second tile to be solid", path)
+		}
+	}
+}
+
+func TestLoadFlattensGroups(t *testing.T) {
+	for _, path := range []string{"csv.tmx", "map.tmj"} {
+		m, err := tiled.Load(fixtures, path)
+		if err != nil {
+			t.Fatalf("%s: Load failed: %v", path, err)
+		}
+		if len(m.Layers) != 2 {
+			t.Fatalf("%s: expected 2 layers, got %d", path, len(m.Layers))
+		}
+		spawns, ok := m.LayerByName("spawns")
+		if !ok || spawns.Type != tiled.ObjectLayer {
+			t.Fatalf("%s: expected the object layer of the nested group", path)
+		}
+		if len(spawns.Objects) != 2 {
+			t.Fatalf("%s: expected 2 objects, got %d", path, len(spawns.Objects))
+		}
+		player := spawns.Objects[0]
+		if player.Name != "player" || player.Class != "spawn" || !player.Point || player.X != 8 || player.Y != 12 {
+			t.Errorf("%s: the player spawn was not loaded: %+v", path, player)
+		}
+		chest := spawns.Objects[1]
+		if chest.Class != "chest" || chest.Point || chest.Width != 16 || chest.Height != 16 {
+			t.Errorf("%s: the chest was not loaded: %+v", path, chest)
+		}
+	}
+}
+
+func TestLoadPassesGroupsOnToTheirLayers(t *testing.T) {
+	for _, path := range []string{"groups.tmx", "groups.tmj"} {
+		m, err := tiled.Load(fixtures, path)
+		if err != nil {
+			t.Fatalf("%s: Load failed: %v", path, err)
+		}
+		ground, _ := m.LayerByName("ground")
+		if !ground.Visible || ground.OffsetX != 0 || ground.OffsetY != 0 {
+			t.Errorf("%s: expected the ground to be visible and not moved: %+v", path, ground)
+		}
+		// The layer itself is visible but its group is hidden
+		secret, ok := m.LayerByName("secret")
+		if !ok {
+			t.Fatalf("%s: expected the layer of the hidden group", path)
+		}
+		if secret.Visible {
+			t.Errorf("%s: expected the layer of the hidden group to be hidden", path)
+		}
+		if secret.OffsetX != 16 || secret.OffsetY != 16 {
+			t.Errorf("%s: expected the offset 16, 16, got %v, %v", path, secret.OffsetX, secret.OffsetY)
+		}
+		// The offsets of both groups and the layer add up
+		spawns, ok := m.LayerByName("spawns")
+		if !ok {
+			t.Fatalf("%s: expected the object layer of the nested group", path)
+		}
+		if !spawns.Visible || spawns.OffsetX != 12 || spawns.OffsetY != 16 {
+			t.Errorf("%s: expected the visible spawns with the offset 12, 16, got %v with %v, %v", path, spawns.Visible, spawns.OffsetX, spawns.OffsetY)
+		}
+	}
+}
+
+func TestLoadErrors(t *testing.T) {
+	tests := []struct {
+		path string
+		want string // A part of the expected error message
+	}{
+		{"bad_compression.tmx", "unsupported tile compression"},
+		{"wrong_count.tmx", "has 3 tiles but the map has 4"},
+		{"infinite.tmx", "infinite maps are not supported"},
+		{"infinite.tmj", "infinite maps are not supported"},
+		{"no_columns.tmx", "no valid tile size"},
+		{"missing.tmx", "failed to load map"},
+		{"map.txt", "unsupported map format"},
+	}
+	for _, tt := range tests {
+		_, err := tiled.Load(fixtures, tt.path)
+		if err == nil || !strings.Contains(err.Error(), tt.want) {
+			t.Errorf("%s: expected an error containing %q, got %v", tt.path, tt.want, err)
+		}
+	}
+}
+
+func TestTilesetFor(t *testing.T) {
+	m := &tiled.Map{Tilesets: []*tiled.Tileset{
+		{Name: "terrain", FirstGID: 1, TileCount: 4, Columns: 2},
+		{Name: "decor", FirstGID: 10, TileCount: 2, Columns: 2},
+	}}
+	tests := []struct {
+		gid     uint32
+		tileset string // Empty if the gid has no tile
+		localID uint32
+	}{
+		{0, "", 0},
+		{1, "terrain", 0},
+		{0x80000004, "terrain", 3}, // The flip flags are ignored
+		{5, "", 0},                 // After the last tile of the terrain
+		{9, "", 0},
+		{11, "decor", 1},
+		{12, "", 0},
+	}
+	for _, tt := range tests {
+		tileset, localID, ok := m.TilesetFor(tt.gid)
+		if tt.tileset == "" {
+			if ok {
+				t.Errorf("gid %d: expected no tile, got %s %d", tt.gid, tileset.Name, localID)
+			}
+			continue
+		}
+		if !ok || tileset.Name != tt.tileset || localID != tt.localID {
+			t.Errorf("gid %d: expected %s %d, got %v %d %v", tt.gid, tt.tileset, tt.localID, tileset, localID, ok)
+		}
+	}
+}
+
+func TestTileRect(t *testing.T) {
+	tileset := &tiled.Tileset{TileWidth: 16, TileHeight: 16, Columns: 3, TileCount: 9, Margin: 1, Spacing: 2}
+	rect := tileset.TileRect(4) // The second column of the second row
+	if rect.Min.X != 19 || rect.Min.Y != 19 || rect.Dx() != 16 || rect.Dy() != 16 {
+		t.Errorf("Unexpected rect %v", rect)
+	}
+}
@@ -0,0 +1,336 @@
+package tiled
+
+import (
+	"bytes"
+	"compress/gzip"
+	"compress/zlib"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"io/fs"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// The structure of maps and tilesets stored as tmx/tsx by tiled.
+// The xml decoder does not keep the order of different layer types.
+// Layers are found by their name so the order does not matter for the game.
+
+type tmxProperty struct {
+	Name      string `xml:"name,attr"`
+	Value     string `xml:"value,attr"`
+	Multiline string `xml:",chardata"` // Multiline strings are stored as text
+}
+
+type tmxProperties struct {
+	Properties []tmxProperty `xml:"property"`
+}
+
+type tmxObject struct {
+	ID         int            `xml:"id,attr"`
+	Name       string         `xml:"name,attr"`
+	Type       string         `xml:"type,attr"`
+	Class      string         `xml:"class,attr"`
+	X          float64        `xml:"x,attr"`
+	Y          float64        `xml:"y,attr"`
+	Width      float64        `xml:"width,attr"`
+	Height     float64        `xml:"height,attr"`
+	Point      *struct{}      `xml:"point"`
+	Properties *tmxProperties `xml:"properties"`
+}
+
+type tmxData struct {
+	Encoding    string `xml:"encoding,attr"`
+	Compression string `xml:"compression,attr"`
+	Content     string `xml:",chardata"`
+	Tiles       []struct {
+		GID uint32 `xml:"gid,attr"`
+	} `xml:"tile"`
+}
+
+type tmxLayer struct {
+	Name       string         `xml:"name,attr"`
+	Visible    *int           `xml:"visible,attr"`
+	OffsetX    float64        `xml:"offsetx,attr"`
+	OffsetY    float64        `xml:"offsety,attr"`
+	Data       tmxData        `xml:"data"`
+	Properties *tmxProperties `xml:"properties"`
+}
+
+type tmxObjectGroup struct {
+	Name       string         `xml:"name,attr"`
+	Visible    *int           `xml:"visible,attr"`
+	OffsetX    float64        `xml:"offsetx,attr"`
+	OffsetY    float64        `xml:"offsety,attr"`
+	Objects    []tmxObject    `xml:"object"`
+	Properties *tmxProperties `xml:"properties"`
+}
+
+// The map itself is read as the outermost group
+type tmxGroup struct {
+	Visible      *int             `xml:"visible,attr"`
+	OffsetX      float64          `xml:"offsetx,attr"`
+	OffsetY      float64          `xml:"offsety,attr"`
+	Layers       []tmxLayer       `xml:"layer"`
+	ObjectGroups []tmxObjectGroup `xml:"objectgroup"`
+	Groups       []tmxGroup       `xml:"group"`
+}
+
+type tmxTileset struct {
+	FirstGID   uint32 `xml:"firstgid,attr"`
+	Source     string `xml:"source,attr"`
+	Name       string `xml:"name,attr"`
+	TileWidth  int    `xml:"tilewidth,attr"`
+	TileHeight int    `xml:"tileheight,attr"`
+	Columns    int    `xml:"columns,attr"`
+	TileCount  int    `xml:"tilecount,attr"`
+	Margin     int    `xml:"margin,attr"`
+	Spacing    int    `xml:"spacing,attr"`
+	Image      struct {
+		Source string `xml:"source,attr"`
+	} `xml:"image"`
+	Tiles []struct {
+		ID         uint32         `xml:"id,attr"`
+		Properties *tmxProperties `xml:"properties"`
+	} `xml:"tile"`
+}
+
+type tmxMap struct {
+	tmxGroup
+	Width      int            `xml:"width,attr"`
+	Height     int            `xml:"height,attr"`
+	TileWidth  int            `xml:"tilewidth,attr"`
+	TileHeight int            `xml:"tileheight,attr"`
+	Infinite   int            `xml:"infinite,attr"`
+	Tilesets   []tmxTileset   `xml:"tileset"`
+	Properties *tmxProperties `xml:"properties"`
+}
+
+func loadTMX(fsys fs.FS, path string) (*Map, error) {
+	data, err := fs.ReadFile(fsys, path)
+	if err != nil {
+		return nil, err
+	}
+	var raw tmxMap
+	if err := xml.Unmarshal(data, &raw); err != nil {
+		return nil, err
+	}
+	if raw.Infinite != 0 {
+		return nil, fmt.Errorf("infinite maps are not supported")
+	}
+
+	m := &Map{
+		Width:      raw.Width,
+		Height:     raw.Height,
+		TileWidth:  raw.TileWidth,
+		TileHeight: raw.TileHeight,
+		Properties: convertTMXProperties(raw.Properties),
+	}
+
+	for _, rawTileset := range raw.Tilesets {
+		var tileset *Tileset
+		if rawTileset.Source != "" {
+			source := resolvePath(path, rawTileset.Source)
+			if extension(source) == ".tsx" {
+				tileset, err = loadTSX(fsys, source)
+			} else {
+				// Json tilesets can also be used in tmx maps
+				tileset, err = convertJSONTileset(fsys, path, jsonTileset{Source: rawTileset.Source})
+			}
+			if err != nil {
+				return nil, err
+			}
+			tileset.FirstGID = rawTileset.FirstGID
+		} else {
+			tileset = convertTMXTileset(path, rawTileset)
+		}
+		m.Tilesets = append(m.Tilesets, tileset)
+	}
+	sort.Slice(m.Tilesets, func(i, j int) bool { return m.Tilesets[i].FirstGID < m.Tilesets[j].FirstGID })
+
+	layers, err := convertTMXGroup(raw.tmxGroup, rootGroup, m.Width*m.Height)
+	if err != nil {
+		return nil, err
+	}
+	m.Layers = layers
+	return m, nil
+}
+
+// Loads a tileset stored in its own tsx file
+func loadTSX(fsys fs.FS, path string) (*Tileset, error) {
+	data, err := fs.ReadFile(fsys, path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load tileset %s: %w", path, err)
+	}
+	var raw tmxTileset
+	if err := xml.Unmarshal(data, &raw); err != nil {
+		return nil, fmt.Errorf("failed to load tileset %s: %w", path, err)
+	}
+	return convertTMXTileset(path, raw), nil
+}
+
+func convertTMXTileset(path string, raw tmxTileset) *Tileset {
+	tileset := &Tileset{
+		FirstGID:       raw.FirstGID,
+		Name:           raw.Name,
+		Image:          resolvePath(path, raw.Image.Source),
+		TileWidth:      raw.TileWidth,
+		TileHeight:     raw.TileHeight,
+		Columns:        raw.Columns,
+		TileCount:      raw.TileCount,
+		Margin:         raw.Margin,
+		Spacing:        raw.Spacing,
+		TileProperties: make(map[uint32]Properties),
+	}
+	for _, tile := range raw.Tiles {
+		tileset.TileProperties[tile.ID] = convertTMXProperties(tile.Properties)
+	}
+	return tileset
+}
+
+func convertTMXGroup(group tmxGroup, parent groupState, tileAmount int) ([]*Layer, error) {
+	state := parent.child(tmxVisible(group.Visible), group.OffsetX, group.OffsetY)
+	var layers []*Layer
+	for _, raw := range group.Layers {
+		layerState := state.child(tmxVisible(raw.Visible), raw.OffsetX, raw.OffsetY)
+		tiles, err := decodeTMXTileData(raw.Data, tileAmount)
+		if err != nil {
+			return nil, fmt.Errorf("layer '%s': %w", raw.Name, err)
+		}
+		layers = append(layers, &Layer{
+			Name:       raw.Name,
+			Type:       TileLayer,
+			Visible:    layerState.visible,
+			OffsetX:    layerState.offsetX,
+			OffsetY:    layerState.offsetY,
+			Tiles:      tiles,
+			Properties: convertTMXProperties(raw.Properties),
+		})
+	}
+	for _, raw := range group.ObjectGroups {
+		layerState := state.child(tmxVisible(raw.Visible), raw.OffsetX, raw.OffsetY)
+		layer := &Layer{
+			Name:       raw.Name,
+			Type:       ObjectLayer,
+			Visible:    layerState.visible,
+			OffsetX:    layerState.offsetX,
+			OffsetY:    layerState.offsetY,
+			Properties: convertTMXProperties(raw.Properties),
+		}
+		for _, obj := range raw.Objects {
+			class := obj.Class
+			if class == "" {
+				class = obj.Type
+			}
+			layer.Objects = append(layer.Objects, Object{
+				ID:         obj.ID,
+				Name:       obj.Name,
+				Class:      class,
+				X:          obj.X,
+				Y:          obj.Y,
+				Width:      obj.Width,
+				Height:     obj.Height,
+				Point:      obj.Point != nil,
+				Properties: convertTMXProperties(obj.Properties),
+			})
+		}
+		layers = append(layers, layer)
+	}
+	for _, child := range group.Groups {
+		children, err := convertTMXGroup(child, state, tileAmount)
+		if err != nil {
+			return nil, err
+		}
+		layers = append(layers, children...)
+	}
+	return layers, nil
+}
+
+// Layers and groups without the visible attribute are visible
+func tmxVisible(visible *int) bool {
+	return visible == nil || *visible != 0
+}
+
+// Tile data can be stored as csv, base64 or as a list of xml elements
+func decodeTMXTileData(data tmxData, tileAmount int) ([]uint32, error) {
+	switch data.Encoding {
+	case "csv":
+		tiles := make([]uint32, 0, tileAmount)
+		for _, field := range strings.Split(data.Content, ",") {
+			field = strings.TrimSpace(field)
+			if field == "" {
+				continue
+			}
+			gid, err := strconv.ParseUint(field, 10, 32)
+			if err != nil {
+				return nil, err
+			}
+			tiles = append(tiles, uint32(gid))
+		}
+		return tiles, nil
+	case "base64":
+		return decodeBase64Tiles(data.Content, data.Compression)
+	case "":
+		tiles := make([]uint32, 0, len(data.Tiles))
+		for _, tile := range data.Tiles {
+			tiles = append(tiles, tile.GID)
+		}
+		return tiles, nil
+	default:
+		return nil, fmt.Errorf("unsupported tile encoding: %s", data.Encoding)
+	}
+}
+
+// Base64 tile data is a list of little endian uint32 gids.
+// It can be compressed with zlib or gzip.
+func decodeBase64Tiles(encoded string, compression string) ([]uint32, error) {
+	decoded, err := base64.StdEncoding.DecodeString(strings.TrimSpace(encoded))
+	if err != nil {
+		return nil, err
+	}
+
+	var reader io.Reader = bytes.NewReader(decoded)
+	switch compression {
+	case "":
+	case "zlib":
+		reader, err = zlib.NewReader(reader)
+	case "gzip":
+		reader, err = gzip.NewReader(reader)
+	default:
+		return nil, fmt.Errorf("unsupported tile compression: %s", compression)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	raw, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, err
+	}
+	if len(raw)%4 != 0 {
+		return nil, fmt.Errorf("tile data has an invalid length of %d bytes", len(raw))
+	}
+	tiles := make([]uint32, len(raw)/4)
+	for i := range tiles {
+		tiles[i] = binary.LittleEndian.Uint32(raw[i*4:])
+	}
+	return tiles, nil
+}
+
+func convertTMXProperties(raw *tmxProperties) Properties {
+	properties := make(Properties)
+	if raw == nil {
+		return properties
+	}
+	for _, property := range raw.Properties {
+		value := property.Value
+		if value == "" {
+			value = property.Multiline
+		}
+		properties[property.Name] = value
+	}
+	return properties
+}
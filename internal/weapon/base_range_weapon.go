@@ -4,9 +4,11 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/N3moAhead/harvest/internal/camera"
 	"github.com/N3moAhead/harvest/internal/entity/enemy"
 	"github.com/N3moAhead/harvest/internal/entity/item/itemtype"
 	"github.com/N3moAhead/harvest/internal/entity/player"
+	"github.com/N3moAhead/harvest/internal/entity/projectile"
 	"github.com/hajimehoshi/ebiten/v2"
 )
 
@@ -22,6 +24,7 @@ type RangeWeaponStats struct {
 	Pierce    int
 	Duration  time.Duration
 	Knockback float64
+	Bounces   int // How often a projectile bounces off of walls
 }
 
 type RangeBaseWeapon struct {
@@ -98,8 +101,7 @@ func (b *RangeBaseWeapon) ResetCooldown(player *player.Player) {
 func (b *RangeBaseWeapon) Draw(
 	screen *ebiten.Image,
 	player *player.Player,
-	mapOffsetX float64,
-	mapOffsetY float64,
+	cam *camera.Camera,
 ) {
 	fmt.Println("Warning: Draw is not implemented in ", b.GetType().String())
 }
@@ -107,6 +109,7 @@ func (b *RangeBaseWeapon) Draw(
 func (b *RangeBaseWeapon) Update(
 	player *player.Player,
 	enemies []enemy.EnemyInterface,
+	terrain projectile.TerrainProvider,
 	dt time.Duration,
 ) {
 	fmt.Println("Warning: Update is not implemented in ", b.GetType().String())
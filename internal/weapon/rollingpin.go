@@ -2,16 +2,19 @@ package weapon
 
 import (
 	"fmt"
-	"image"
 	"math"
 	"time"
 
+	"github.com/N3moAhead/harvest/internal/animation"
 	"github.com/N3moAhead/harvest/internal/assets"
+	"github.com/N3moAhead/harvest/internal/camera"
 	"github.com/N3moAhead/harvest/internal/collision"
 	"github.com/N3moAhead/harvest/internal/component"
+	"github.com/N3moAhead/harvest/internal/drawstats"
 	"github.com/N3moAhead/harvest/internal/entity/enemy"
 	"github.com/N3moAhead/harvest/internal/entity/item/itemtype"
 	"github.com/N3moAhead/harvest/internal/entity/player"
+	"github.com/N3moAhead/harvest/internal/entity/projectile"
 	"github.com/hajimehoshi/ebiten/v2"
 )
 
@@ -25,10 +28,7 @@ const (
 
 type RollingPin struct {
 	BaseWeapon
-	rollImage    *ebiten.Image
-	rollSound    []byte
-	frameTimer   int
-	currentFrame int
+	rollAnim     *animation.AnimationStore // Plays the sound of the weapon on its first frame
 	displayRoll  bool
 	hitDirection component.Vector2D
 }
@@ -61,13 +61,9 @@ func NewRollingPin() *RollingPin {
 		},
 	}
 
-	rollImage, ok := assets.AssetStore.GetImage("rolling_pin_roll")
+	rollAnim, ok := assets.AssetStore.GetAnimations("rolling_pin")
 	if !ok {
-		fmt.Println("Warning: Rolling pin roll image not found")
-	}
-	rollSound, ok := assets.AssetStore.GetSFXData("rolling_pin_roll")
-	if !ok {
-		fmt.Println("Warning: Rolling pin roll sound not found")
+		fmt.Println("Warning: Rolling pin roll animation not found")
 	}
 	return &RollingPin{
 		BaseWeapon: BaseWeapon{
@@ -79,24 +75,17 @@ func NewRollingPin() *RollingPin {
 			statsPerLevel: stats,
 			itemType:      itemtype.RollingPin,
 		},
-		rollImage: rollImage,
-		rollSound: rollSound,
+		rollAnim: rollAnim,
 	}
 }
 
-func (rp *RollingPin) Update(player *player.Player, enemies []enemy.EnemyInterface, dt time.Duration) {
+func (rp *RollingPin) Update(player *player.Player, enemies []enemy.EnemyInterface, terrain projectile.TerrainProvider, dt time.Duration) {
 	// Update the cooldown
 	canAttack := rp.UpdateCooldown(dt)
 
-	// Update the animation frames
-	rp.frameTimer++
-	if rp.frameTimer >= animationSpeed {
-		rp.frameTimer = 0 // Reset the frame timer
-		if rp.currentFrame == frameCount-1 {
-			rp.displayRoll = false
-		} else {
-			rp.currentFrame = (rp.currentFrame + 1) % frameCount
-		}
+	// Update the animation
+	if rp.displayRoll && rp.rollAnim.Update() {
+		rp.displayRoll = false
 	}
 
 	if canAttack {
@@ -130,46 +119,34 @@ func (rp *RollingPin) Update(player *player.Player, enemies []enemy.EnemyInterfa
 			hits++
 		}
 
-		// Start the animation
-		rp.displayRoll = true
-		rp.currentFrame = 0
-		rp.frameTimer = 0
+		// Start the animation. Its first frame plays the sound.
+		if rp.rollAnim != nil {
+			rp.displayRoll = rp.rollAnim.Restart("roll")
+		}
 		rp.hitDirection = player.GetFacingDirection()
-
-		// Play the sound
-		sfxPlayer := assets.AudioContext.NewPlayerFromBytes(rp.rollSound)
-		sfxPlayer.Play()
 	}
 }
 
-func (rp *RollingPin) Draw(screen *ebiten.Image, player *player.Player, mapOffsetX float64, mapOffsetY float64) {
-	if rp.rollImage == nil {
-		return
-	}
-
+func (rp *RollingPin) Draw(screen *ebiten.Image, player *player.Player, cam *camera.Camera) {
 	if rp.displayRoll {
-		sx := rp.currentFrame * frameWidth
-		sy := 0
-		frameRect := image.Rect(sx, sy, sx+frameWidth, sy+frameHeight)
-
-		bounds := rp.rollImage.Bounds()
-		if !frameRect.In(bounds) {
-			fmt.Printf("Error: Frame rect %v out of bounds %v\n", frameRect, bounds)
+		frameImage := rp.rollAnim.GetImage()
+		if frameImage == nil {
 			return
 		}
-		frameImage := rp.rollImage.SubImage(frameRect).(*ebiten.Image)
+		frameWidth := float64(frameImage.Bounds().Dx())
+		frameHeight := float64(frameImage.Bounds().Dy())
 
-		pivotX := float64(frameWidth) / 2.0
-		pivotY := float64(frameHeight) / 2.0
+		pivotX := frameWidth / 2.0
+		pivotY := frameHeight / 2.0
 		angle := math.Atan2(rp.hitDirection.Y, rp.hitDirection.X)
 
 		stats := rp.CurrentStats(player)
 		currentRadius := baseRollingPinRadius * stats.AreaSize
-		calculatedScale := currentRadius / float64(frameWidth)
+		calculatedScale := currentRadius / frameWidth
 
-		finalScreen := component.NewVector2D(player.Pos.X-mapOffsetX, player.Pos.Y-mapOffsetY)
+		drawPos := player.Pos
 		// Moving the animation to the outside of the player
-		finalScreen = finalScreen.Add(rp.hitDirection.Mul(frameWidth / 2)) // TODO i dont know if frameWidth / 2 is the correct value
+		drawPos = drawPos.Add(rp.hitDirection.Mul(frameWidth / 2)) // TODO i dont know if frameWidth / 2 is the correct value
 
 		op := &ebiten.DrawImageOptions{}
 
@@ -178,8 +155,9 @@ func (rp *RollingPin) Draw(screen *ebiten.Image, player *player.Player, mapOffse
 			op.GeoM.Scale(calculatedScale, calculatedScale)
 		}
 		op.GeoM.Rotate(angle)
-		op.GeoM.Translate(finalScreen.X, finalScreen.Y)
+		op.GeoM.Translate(drawPos.X, drawPos.Y)
+		op.GeoM.Concat(cam.GeoM())
 
-		screen.DrawImage(frameImage, op)
+		drawstats.DrawImage(screen, frameImage, op)
 	}
 }
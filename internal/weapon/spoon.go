@@ -2,34 +2,30 @@ package weapon
 
 import (
 	"fmt"
-	"image"
 	"math"
 	"time"
 
+	"github.com/N3moAhead/harvest/internal/animation"
 	"github.com/N3moAhead/harvest/internal/assets"
+	"github.com/N3moAhead/harvest/internal/camera"
 	"github.com/N3moAhead/harvest/internal/collision"
 	"github.com/N3moAhead/harvest/internal/component"
+	"github.com/N3moAhead/harvest/internal/drawstats"
 	"github.com/N3moAhead/harvest/internal/entity/enemy"
 	"github.com/N3moAhead/harvest/internal/entity/item/itemtype"
 	"github.com/N3moAhead/harvest/internal/entity/player"
+	"github.com/N3moAhead/harvest/internal/entity/projectile"
 	"github.com/hajimehoshi/ebiten/v2"
 )
 
 const (
 	baseSpoonRadius  = 100.0   // Basic range of the hit in pixels
 	spoonAttackAngle = math.Pi // 180 degrees
-	frameWidth       = 32      // The height of each slash animation frame
-	frameHeight      = 64      // The width of each slash animation frame
-	frameCount       = 4       // The amount of existing frames
-	animationSpeed   = 6       // Ticks for each frame
 )
 
 type Spoon struct {
 	BaseWeapon
-	slashImage   *ebiten.Image
-	slashSound   []byte
-	frameTimer   int
-	currentFrame int
+	slashAnim    *animation.AnimationStore // Plays the sound of the weapon on its first frame
 	displaySlash bool
 	hitDirection component.Vector2D
 }
@@ -62,13 +58,9 @@ func NewSpoon() *Spoon {
 		},
 	}
 
-	slashImage, ok := assets.AssetStore.GetImage("spoon_slash")
+	slashAnim, ok := assets.AssetStore.GetAnimations("spoon")
 	if !ok {
-		fmt.Println("Warning: Spoon slash image not found")
-	}
-	slashSound, ok := assets.AssetStore.GetSFXData("spoon_slash")
-	if !ok {
-		fmt.Println("Warning: Spoon slash sound not found")
+		fmt.Println("Warning: Spoon slash animation not found")
 	}
 	return &Spoon{
 		BaseWeapon: BaseWeapon{
@@ -80,24 +72,17 @@ func NewSpoon() *Spoon {
 			statsPerLevel: stats,
 			itemType:      itemtype.Spoon,
 		},
-		slashImage: slashImage,
-		slashSound: slashSound,
+		slashAnim: slashAnim,
 	}
 }
 
-func (s *Spoon) Update(player *player.Player, enemies []enemy.EnemyInterface, dt time.Duration) {
+func (s *Spoon) Update(player *player.Player, enemies []enemy.EnemyInterface, terrain projectile.TerrainProvider, dt time.Duration) {
 	// Update the cooldown
 	canAttack := s.UpdateCooldown(dt)
 
-	// Update the animation frames
-	s.frameTimer++
-	if s.frameTimer >= animationSpeed {
-		s.frameTimer = 0 // Reset the frame timer
-		if s.currentFrame == frameCount-1 {
-			s.displaySlash = false
-		} else {
-			s.currentFrame = (s.currentFrame + 1) % frameCount
-		}
+	// Update the animation
+	if s.displaySlash && s.slashAnim.Update() {
+		s.displaySlash = false
 	}
 
 	if canAttack {
@@ -131,46 +116,34 @@ func (s *Spoon) Update(player *player.Player, enemies []enemy.EnemyInterface, dt
 			// TODO play spoon_hit sound
 		}
 
-		// Start the animation
-		s.displaySlash = true
-		s.currentFrame = 0
-		s.frameTimer = 0
+		// Start the animation. Its first frame plays the sound.
+		if s.slashAnim != nil {
+			s.displaySlash = s.slashAnim.Restart("slash")
+		}
 		s.hitDirection = player.GetFacingDirection()
-
-		// Play the sound
-		sfxPlayer := assets.AudioContext.NewPlayerFromBytes(s.slashSound)
-		sfxPlayer.Play()
 	}
 }
 
-func (s *Spoon) Draw(screen *ebiten.Image, player *player.Player, mapOffsetX float64, mapOffsetY float64) {
-	if s.slashImage == nil {
-		return
-	}
-
+func (s *Spoon) Draw(screen *ebiten.Image, player *player.Player, cam *camera.Camera) {
 	if s.displaySlash {
-		sx := s.currentFrame * frameWidth
-		sy := 0
-		frameRect := image.Rect(sx, sy, sx+frameWidth, sy+frameHeight)
-
-		bounds := s.slashImage.Bounds()
-		if !frameRect.In(bounds) {
-			fmt.Printf("Error: Frame rect %v out of bounds %v\n", frameRect, bounds)
+		frameImage := s.slashAnim.GetImage()
+		if frameImage == nil {
 			return
 		}
-		frameImage := s.slashImage.SubImage(frameRect).(*ebiten.Image)
+		frameWidth := float64(frameImage.Bounds().Dx())
+		frameHeight := float64(frameImage.Bounds().Dy())
 
-		pivotX := float64(frameWidth) / 2.0
-		pivotY := float64(frameHeight) / 2.0
+		pivotX := frameWidth / 2.0
+		pivotY := frameHeight / 2.0
 		angle := math.Atan2(s.hitDirection.Y, s.hitDirection.X)
 
 		stats := s.CurrentStats(player)
 		currentRadius := baseSpoonRadius * stats.AreaSize
-		calculatedScale := currentRadius / float64(frameWidth)
+		calculatedScale := currentRadius / frameWidth
 
-		finalScreen := component.NewVector2D(player.Pos.X-mapOffsetX, player.Pos.Y-mapOffsetY)
+		drawPos := player.Pos
 		// Moving the animation to the outside of the player
-		finalScreen = finalScreen.Add(s.hitDirection.Mul(frameWidth / 2)) // TODO i dont know if frameWidth / 2 is the correct value
+		drawPos = drawPos.Add(s.hitDirection.Mul(frameWidth / 2)) // TODO i dont know if frameWidth / 2 is the correct value
 
 		op := &ebiten.DrawImageOptions{}
 
@@ -179,8 +152,9 @@ func (s *Spoon) Draw(screen *ebiten.Image, player *player.Player, mapOffsetX flo
 			op.GeoM.Scale(calculatedScale, calculatedScale)
 		}
 		op.GeoM.Rotate(angle)
-		op.GeoM.Translate(finalScreen.X, finalScreen.Y)
+		op.GeoM.Translate(drawPos.X, drawPos.Y)
+		op.GeoM.Concat(cam.GeoM())
 
-		screen.DrawImage(frameImage, op)
+		drawstats.DrawImage(screen, frameImage, op)
 	}
 }
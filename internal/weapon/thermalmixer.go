@@ -2,34 +2,30 @@ package weapon
 
 import (
 	"fmt"
-	"image"
 	"math"
 	"time"
 
+	"github.com/N3moAhead/harvest/internal/animation"
 	"github.com/N3moAhead/harvest/internal/assets"
+	"github.com/N3moAhead/harvest/internal/camera"
 	"github.com/N3moAhead/harvest/internal/collision"
 	"github.com/N3moAhead/harvest/internal/component"
+	"github.com/N3moAhead/harvest/internal/drawstats"
 	"github.com/N3moAhead/harvest/internal/entity/enemy"
 	"github.com/N3moAhead/harvest/internal/entity/item/itemtype"
 	"github.com/N3moAhead/harvest/internal/entity/player"
+	"github.com/N3moAhead/harvest/internal/entity/projectile"
 	"github.com/hajimehoshi/ebiten/v2"
 )
 
 const (
-	baseThermalmixerRadius     = 150.0       // Basic range of the hit in pixels
-	thermalmixerAttackAngle    = 2 * math.Pi // 360 degrees
-	thermalmixerFrameWidth     = 64          // The width of each slash animation frame
-	thermalmixerFrameHeight    = 64          // The height of each slash animation frame
-	thermalmixerFrameCount     = 8           // The amount of existing frames
-	thermalmixerAnimationSpeed = 8           // Ticks for each frame
+	baseThermalmixerRadius  = 150.0       // Basic range of the hit in pixels
+	thermalmixerAttackAngle = 2 * math.Pi // 360 degrees
 )
 
 type Thermalmixer struct {
 	BaseWeapon
-	slashImage   *ebiten.Image
-	slashSound   []byte
-	frameTimer   int
-	currentFrame int
+	slashAnim    *animation.AnimationStore // Plays the sound of the weapon on its first frame
 	displaySlash bool
 	hitDirection component.Vector2D
 }
@@ -62,13 +58,9 @@ func NewThermalmixer() *Thermalmixer {
 		},
 	}
 
-	slashImage, ok := assets.AssetStore.GetImage("thermalmixer_slash")
+	slashAnim, ok := assets.AssetStore.GetAnimations("thermalmixer")
 	if !ok {
-		fmt.Println("Warning: Thermalmixer slash image not found")
-	}
-	slashSound, ok := assets.AssetStore.GetSFXData("thermalmixer_slash")
-	if !ok {
-		fmt.Println("Warning: Thermalmixer slash sound not found")
+		fmt.Println("Warning: Thermalmixer slash animation not found")
 	}
 	return &Thermalmixer{
 		BaseWeapon: BaseWeapon{
@@ -80,24 +72,17 @@ func NewThermalmixer() *Thermalmixer {
 			statsPerLevel: stats,
 			itemType:      itemtype.Thermalmixer,
 		},
-		slashImage: slashImage,
-		slashSound: slashSound,
+		slashAnim: slashAnim,
 	}
 }
 
-func (t *Thermalmixer) Update(player *player.Player, enemies []enemy.EnemyInterface, dt time.Duration) {
+func (t *Thermalmixer) Update(player *player.Player, enemies []enemy.EnemyInterface, terrain projectile.TerrainProvider, dt time.Duration) {
 	// Update the cooldown
 	canAttack := t.UpdateCooldown(dt)
 
-	// Update the animation frames
-	t.frameTimer++
-	if t.frameTimer >= thermalmixerAnimationSpeed {
-		t.frameTimer = 0 // Reset the frame timer
-		if t.currentFrame == frameCount-1 {
-			t.displaySlash = false
-		} else {
-			t.currentFrame = (t.currentFrame + 1) % thermalmixerFrameCount
-		}
+	// Update the animation
+	if t.displaySlash && t.slashAnim.Update() {
+		t.displaySlash = false
 	}
 
 	if canAttack {
@@ -128,45 +113,33 @@ func (t *Thermalmixer) Update(player *player.Player, enemies []enemy.EnemyInterf
 			// TODO play thermalmixer_hit sound
 		}
 
-		// Start the animation
-		t.displaySlash = true
-		t.currentFrame = 0
-		t.frameTimer = 0
+		// Start the animation. Its first frame plays the sound.
+		if t.slashAnim != nil {
+			t.displaySlash = t.slashAnim.Restart("slash")
+		}
 		t.hitDirection = player.GetFacingDirection()
-
-		// Play the sound
-		sfxPlayer := assets.AudioContext.NewPlayerFromBytes(t.slashSound)
-		sfxPlayer.Play()
 	}
 }
 
-func (t *Thermalmixer) Draw(screen *ebiten.Image, player *player.Player, mapOffsetX float64, mapOffsetY float64) {
-	if t.slashImage == nil {
-		return
-	}
-
+func (t *Thermalmixer) Draw(screen *ebiten.Image, player *player.Player, cam *camera.Camera) {
 	if t.displaySlash {
-		sx := t.currentFrame * thermalmixerFrameWidth
-		sy := 0
-		frameRect := image.Rect(sx, sy, sx+thermalmixerFrameWidth, sy+thermalmixerFrameHeight)
-
-		bounds := t.slashImage.Bounds()
-		if !frameRect.In(bounds) {
-			fmt.Printf("Error: Frame rect %v out of bounds %v\n", frameRect, bounds)
+		frameImage := t.slashAnim.GetImage()
+		if frameImage == nil {
 			return
 		}
-		frameImage := t.slashImage.SubImage(frameRect).(*ebiten.Image)
+		frameWidth := float64(frameImage.Bounds().Dx())
+		frameHeight := float64(frameImage.Bounds().Dy())
 
-		pivotX := float64(thermalmixerFrameWidth) / 2.0
-		pivotY := float64(thermalmixerFrameHeight) / 2.0
+		pivotX := frameWidth / 2.0
+		pivotY := frameHeight / 2.0
 		angle := math.Atan2(t.hitDirection.Y, t.hitDirection.X)
 
 		stats := t.CurrentStats(player)
 		currentRadius := baseThermalmixerRadius * stats.AreaSize
-		calculatedScale := currentRadius / float64(thermalmixerFrameWidth)
+		calculatedScale := currentRadius / frameWidth
 
-		// Calculate sprite position on the screen
-		finalScreen := component.NewVector2D(player.Pos.X-mapOffsetX, player.Pos.Y-mapOffsetY)
+		// Calculate sprite position in the world
+		drawPos := player.Pos
 
 		op := &ebiten.DrawImageOptions{}
 
@@ -176,8 +149,9 @@ func (t *Thermalmixer) Draw(screen *ebiten.Image, player *player.Player, mapOffs
 			op.GeoM.Scale(calculatedScale, calculatedScale)
 		}
 		op.GeoM.Rotate(angle)
-		op.GeoM.Translate(finalScreen.X, finalScreen.Y)
+		op.GeoM.Translate(drawPos.X, drawPos.Y)
+		op.GeoM.Concat(cam.GeoM())
 
-		screen.DrawImage(frameImage, op)
+		drawstats.DrawImage(screen, frameImage, op)
 	}
 }
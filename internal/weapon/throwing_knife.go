@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/N3moAhead/harvest/internal/assets"
+	"github.com/N3moAhead/harvest/internal/camera"
 	"github.com/N3moAhead/harvest/internal/entity/enemy"
 	"github.com/N3moAhead/harvest/internal/entity/item/itemtype"
 	"github.com/N3moAhead/harvest/internal/entity/player"
@@ -54,6 +55,7 @@ func NewThrowingKnife() *ThrowingKnife {
 			Duration:         5 * time.Second,
 			Knockback:        60,
 			BulletSpread:     30.0,
+			Bounces:          1,
 		},
 	}
 	return &ThrowingKnife{
@@ -72,18 +74,18 @@ func NewThrowingKnife() *ThrowingKnife {
 func (t *ThrowingKnife) Draw(
 	screen *ebiten.Image,
 	player *player.Player,
-	mapOffsetX, mapOffsetY float64,
+	cam *camera.Camera,
 ) {
 	for _, knife := range t.knifes {
-		knife.Draw(screen, mapOffsetX, mapOffsetY)
+		knife.Draw(screen, cam)
 	}
 }
 
-func (t *ThrowingKnife) Update(player *player.Player, enemies []enemy.EnemyInterface, dt time.Duration) {
+func (t *ThrowingKnife) Update(player *player.Player, enemies []enemy.EnemyInterface, terrain projectile.TerrainProvider, dt time.Duration) {
 	// Update all throwing knifes
 	n := 0
 	for i, knife := range t.knifes {
-		active := knife.Update(enemies)
+		active := knife.Update(enemies, terrain)
 		if active {
 			if n != i {
 				t.knifes[n] = knife
@@ -112,14 +114,11 @@ func (t *ThrowingKnife) Update(player *player.Player, enemies []enemy.EnemyInter
 				stats.HitRadius,
 				stats.Pierce,
 				stats.Knockback,
+				stats.Bounces,
 			))
 		}
 
-		knifeThrowSfx, ok := assets.AssetStore.GetSFXData("knife_throw")
-		if ok {
-			sfxPlayer := assets.AudioContext.NewPlayerFromBytes(knifeThrowSfx)
-			sfxPlayer.Play()
-		}
+		assets.PlaySound("knife_throw")
 	}
 }
 
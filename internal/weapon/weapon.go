@@ -4,19 +4,20 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/N3moAhead/harvest/internal/camera"
 	"github.com/N3moAhead/harvest/internal/entity/enemy"
 	"github.com/N3moAhead/harvest/internal/entity/item/itemtype"
 	"github.com/N3moAhead/harvest/internal/entity/player"
+	"github.com/N3moAhead/harvest/internal/entity/projectile"
 	"github.com/hajimehoshi/ebiten/v2"
 )
 
 type Weapon interface {
-	Update(player *player.Player, enemies []enemy.EnemyInterface, dt time.Duration) // Update the weopon
+	Update(player *player.Player, enemies []enemy.EnemyInterface, terrain projectile.TerrainProvider, dt time.Duration) // Update the weopon
 	Draw(
 		screen *ebiten.Image,
 		player *player.Player,
-		mapOffsetX float64,
-		mapOffsetY float64,
+		cam *camera.Camera,
 	) // Draws the weopon around the player if needed
 	// Functions used for the ui hud
 	GetType() itemtype.ItemType
@@ -0,0 +1,198 @@
+package world
+
+import (
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/N3moAhead/harvest/internal/assets"
+	"github.com/N3moAhead/harvest/internal/component"
+	"github.com/N3moAhead/harvest/internal/config"
+	"github.com/N3moAhead/harvest/internal/tiled"
+	"github.com/N3moAhead/harvest/pkg/util"
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+// Names of the layers, objects and properties used in tiled maps
+const (
+	floorLayerName     = "floor"     // Tiles of this layer are used as FloorImage
+	decorLayerName     = "decor"     // Tiles of this layer are used as DecorImage
+	collisionLayerName = "collision" // Every tile on this layer blocks movement. The layer is not drawn.
+	collisionProperty  = "collision" // Tiles or layers with this property set to true block movement
+	playerSpawnObject  = "player_spawn"
+	enemySpawnObject   = "enemy_spawn" // A rectangle in which enemies can spawn
+	cookStationObject  = "cook_station"
+	weaponObject       = "weapon"
+	soupProperty       = "soup"   // The soup a cook station cooks. Random if not set.
+	weaponProperty     = "weapon" // The weapon lying at a weapon position
+)
+
+// Arena describes the fixed positions placed on a handcrafted map
+type Arena struct {
+	PlayerSpawn     component.Vector2D
+	EnemySpawnZones []component.Rect
+	CookStations    []ArenaSpot
+	Weapons         []ArenaSpot
+}
+
+// A fixed position on an arena. Name is the weapon or
+// soup that belongs to it and can be empty.
+type ArenaSpot struct {
+	Pos  component.Vector2D
+	Name string
+}
+
+// NewWorldFromTiled creates a world from a map made with the tiled editor.
+// The tile size of the map has to match config.TILE_SIZE.
+func NewWorldFromTiled(path string) (*World, error) {
+	m, err := tiled.Load(assets.FS, path)
+	if err != nil {
+		return nil, err
+	}
+	if m.TileWidth != config.TILE_SIZE || m.TileHeight != config.TILE_SIZE {
+		return nil, fmt.Errorf("map %s uses %dx%d tiles but the game needs %dx%d", path, m.TileWidth, m.TileHeight, config.TILE_SIZE, config.TILE_SIZE)
+	}
+
+	tileImages, err := newTiledImageCache(m)
+	if err != nil {
+		return nil, err
+	}
+
+	tiles := make([][]Tile, m.Height)
+	for y := range tiles {
+		tiles[y] = make([]Tile, m.Width)
+		for x := range tiles[y] {
+			tiles[y][x] = Tile{Type: Imported, IsWalkable: true}
+		}
+	}
+
+	for _, layer := range m.Layers {
+		if layer.Type != tiled.TileLayer {
+			continue
+		}
+		name := strings.ToLower(layer.Name)
+		isCollisionLayer := name == collisionLayerName || layer.Properties.Bool(collisionProperty)
+		// The world is a grid so layers can only be moved by whole tiles
+		offsetX := int(math.Round(layer.OffsetX / float64(m.TileWidth)))
+		offsetY := int(math.Round(layer.OffsetY / float64(m.TileHeight)))
+		if float64(offsetX*m.TileWidth) != layer.OffsetX || float64(offsetY*m.TileHeight) != layer.OffsetY {
+			fmt.Printf("Warning: The offset of the layer '%s' of the map %s is rounded to whole tiles\n", layer.Name, path)
+		}
+		for i, gid := range layer.Tiles {
+			if gid == 0 {
+				continue
+			}
+			x := i%m.Width + offsetX
+			y := i/m.Width + offsetY
+			if x < 0 || x >= m.Width || y < 0 || y >= m.Height {
+				continue
+			}
+			tile := &tiles[y][x]
+			if isCollisionLayer || m.TileProperties(gid).Bool(collisionProperty) {
+				tile.IsWalkable = false
+			}
+			if !layer.Visible {
+				continue
+			}
+			switch name {
+			case floorLayerName:
+				tile.FloorImage = tileImages.get(gid)
+			case decorLayerName:
+				tile.DecorImage = tileImages.get(gid)
+			}
+		}
+		if name != floorLayerName && name != decorLayerName && !isCollisionLayer {
+			fmt.Printf("Warning: The layer '%s' of the map %s is neither floor, decor nor collision and gets ignored\n", layer.Name, path)
+		}
+	}
+
+	w := newBoundedWorld(tiles)
+	w.arena = newArena(m, w)
+	return w, nil
+}
+
+// Collects all objects of the object layers into an arena
+func newArena(m *tiled.Map, w *World) *Arena {
+	arena := &Arena{
+		// Without a spawn object the player starts in the center
+		PlayerSpawn: component.NewVector2D(float64(w.mapWidthPx)/2, float64(w.mapHeightPx)/2),
+	}
+	for _, layer := range m.Layers {
+		for _, obj := range layer.Objects {
+			obj.X += layer.OffsetX
+			obj.Y += layer.OffsetY
+			// Rectangles are stored by their top left corner
+			center := component.NewVector2D(obj.X+obj.Width/2, obj.Y+obj.Height/2)
+			switch strings.ToLower(obj.Class) {
+			case playerSpawnObject:
+				arena.PlayerSpawn = center
+			case enemySpawnObject:
+				if obj.Point || obj.Width <= 0 || obj.Height <= 0 {
+					fmt.Printf("Warning: The enemy spawn zone %d needs to be a rectangle\n", obj.ID)
+					continue
+				}
+				arena.EnemySpawnZones = append(arena.EnemySpawnZones, component.Rect{
+					X:      obj.X,
+					Y:      obj.Y,
+					Width:  obj.Width,
+					Height: obj.Height,
+				})
+			case cookStationObject:
+				arena.CookStations = append(arena.CookStations, ArenaSpot{Pos: center, Name: obj.Properties[soupProperty]})
+			case weaponObject:
+				weaponName := obj.Properties[weaponProperty]
+				if weaponName == "" {
+					weaponName = obj.Name
+				}
+				arena.Weapons = append(arena.Weapons, ArenaSpot{Pos: center, Name: weaponName})
+			default:
+				fmt.Printf("Warning: Unknown object class '%s' of object %d in the map\n", obj.Class, obj.ID)
+			}
+		}
+	}
+	return arena
+}
+
+// GetArena returns the fixed positions of a handcrafted map.
+// Procedurally generated worlds do not have an arena.
+func (w *World) GetArena() (arena *Arena, ok bool) {
+	return w.arena, w.arena != nil
+}
+
+// Loads the images of all tilesets and cuts them into tiles on demand
+type tiledImageCache struct {
+	m          *tiled.Map
+	tilesets   map[*tiled.Tileset]*ebiten.Image
+	tileImages map[uint32]*ebiten.Image
+}
+
+func newTiledImageCache(m *tiled.Map) (*tiledImageCache, error) {
+	cache := &tiledImageCache{
+		m:          m,
+		tilesets:   make(map[*tiled.Tileset]*ebiten.Image),
+		tileImages: make(map[uint32]*ebiten.Image),
+	}
+	for _, tileset := range m.Tilesets {
+		img, err := assets.LoadImage(tileset.Image)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load the image of the tileset '%s': %w", tileset.Name, err)
+		}
+		cache.tilesets[tileset] = img
+	}
+	return cache, nil
+}
+
+// Returns the image of the tile with the gid. Flipped tiles are drawn unflipped.
+func (c *tiledImageCache) get(gid uint32) *ebiten.Image {
+	tileset, localID, ok := c.m.TilesetFor(gid)
+	if !ok {
+		return nil
+	}
+	key := tileset.FirstGID + localID
+	if img, ok := c.tileImages[key]; ok {
+		return img
+	}
+	img := util.GetSubImage(c.tilesets[tileset], tileset.TileRect(localID))
+	c.tileImages[key] = img
+	return img
+}
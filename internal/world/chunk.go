@@ -0,0 +1,93 @@
+package world
+
+import (
+	"github.com/N3moAhead/harvest/internal/component"
+	"github.com/N3moAhead/harvest/internal/config"
+	"github.com/N3moAhead/harvest/internal/world/chunkcache"
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+// The position of a chunk. The chunk 0, 0 starts at the origin of the world.
+type ChunkCoord struct {
+	X, Y int
+}
+
+// A chunk is a square block of tiles. The world is made of chunks
+// which are created when the camera gets close and removed again
+// when it is far away.
+type Chunk struct {
+	Coord ChunkCoord
+	tiles [config.CHUNK_SIZE][config.CHUNK_SIZE]Tile
+	baked *chunkcache.Baked // The pre-rendered tiles. Created on the first draw.
+}
+
+// Returns the pre-rendered image of all tiles of the chunk
+func (c *Chunk) image() *ebiten.Image {
+	if c.baked == nil {
+		size := config.CHUNK_SIZE * config.TILE_SIZE
+		c.baked = chunkcache.New(size, size)
+	}
+	return c.baked.Image(c.render)
+}
+
+func (c *Chunk) render(dst *ebiten.Image) {
+	c.drawTiles(dst, ebiten.GeoM{}, 0, 0, config.CHUNK_SIZE, config.CHUNK_SIZE)
+}
+
+// Draws the tiles from the start up to the end tile (exclusive) one by one.
+// The geoM moves the tiles from the chunk into the destination.
+func (c *Chunk) drawTiles(dst *ebiten.Image, geoM ebiten.GeoM, fromX, fromY, toX, toY int) {
+	for y := fromY; y < toY; y++ {
+		for x := fromX; x < toX; x++ {
+			op := &ebiten.DrawImageOptions{}
+			op.GeoM.Translate(float64(x*config.TILE_SIZE), float64(y*config.TILE_SIZE))
+			op.GeoM.Concat(geoM)
+			c.tiles[y][x].drawWithOptions(dst, op)
+		}
+	}
+}
+
+// Marks the pre-rendered image as outdated after a tile changed
+func (c *Chunk) invalidate() {
+	if c.baked != nil {
+		c.baked.Invalidate()
+	}
+}
+
+// Frees the pre-rendered image when the chunk gets removed
+func (c *Chunk) unload() {
+	if c.baked != nil {
+		c.baked.Deallocate()
+	}
+}
+
+// Bounds returns the area of the chunk in world coordinates
+func (c *Chunk) Bounds() component.Rect {
+	size := float64(config.CHUNK_SIZE * config.TILE_SIZE)
+	return component.Rect{
+		X:      float64(c.Coord.X) * size,
+		Y:      float64(c.Coord.Y) * size,
+		Width:  size,
+		Height: size,
+	}
+}
+
+// Returns the coordinate of the chunk that contains the tile
+func chunkCoordOfTile(tileX, tileY int) ChunkCoord {
+	return ChunkCoord{floorDiv(tileX, config.CHUNK_SIZE), floorDiv(tileY, config.CHUNK_SIZE)}
+}
+
+// Division that rounds towards negative infinity so
+// negative tiles end up in the correct chunk
+func floorDiv(a, b int) int {
+	q := a / b
+	if a%b != 0 && (a < 0) != (b < 0) {
+		q--
+	}
+	return q
+}
+
+// The counterpart of floorDiv. The result is always positive.
+func floorMod(a, b int) int {
+	return a - floorDiv(a, b)*b
+}
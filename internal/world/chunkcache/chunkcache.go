@@ -0,0 +1,60 @@
+package chunkcache
+
+import "github.com/hajimehoshi/ebiten/v2"
+
+// RenderFunc draws the content of a chunk onto dst.
+// The top left corner of the chunk is at 0, 0.
+type RenderFunc func(dst *ebiten.Image)
+
+// Baked holds a pre-rendered image of the static tiles of a chunk.
+// Drawing the image is a single draw call instead of one per tile.
+// The image only gets rendered again after Invalidate was called.
+type Baked struct {
+	image  *ebiten.Image
+	width  int
+	height int
+	dirty  bool
+}
+
+// New creates a cache for a chunk of the given size in pixels.
+// The image itself is created the first time it is needed.
+func New(width, height int) *Baked {
+	return &Baked{
+		width:  width,
+		height: height,
+		dirty:  true,
+	}
+}
+
+// Invalidate marks the image as outdated. Call it after a tile of the chunk changed.
+func (b *Baked) Invalidate() {
+	b.dirty = true
+}
+
+func (b *Baked) IsDirty() bool {
+	return b.dirty
+}
+
+// Image returns the pre-rendered image of the chunk.
+// render is only called if the image is outdated.
+func (b *Baked) Image(render RenderFunc) *ebiten.Image {
+	if b.image == nil {
+		b.image = ebiten.NewImage(b.width, b.height)
+	} else if b.dirty {
+		b.image.Clear()
+	}
+	if b.dirty {
+		render(b.image)
+		b.dirty = false
+	}
+	return b.image
+}
+
+// Deallocate frees the image on the gpu. The next call of Image renders it again.
+func (b *Baked) Deallocate() {
+	if b.image != nil {
+		b.image.Deallocate()
+		b.image = nil
+	}
+	b.dirty = true
+}
@@ -0,0 +1,51 @@
+package chunkcache_test
+
+import (
+	"testing"
+
+	"github.com/N3moAhead/harvest/internal/world/chunkcache"
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+const (
+	tileSize  = 16
+	chunkSize = 32
+)
+
+func TestImageOnlyRendersWhenDirty(t *testing.T) {
+	baked := chunkcache.New(chunkSize*tileSize, chunkSize*tileSize)
+	renders := 0
+	render := func(dst *ebiten.Image) { renders++ }
+
+	if !baked.IsDirty() {
+		t.Error("Expected a new cache to be dirty")
+	}
+	img := baked.Image(render)
+	if img == nil {
+		t.Fatal("Image returned nil")
+	}
+	if w, h := img.Bounds().Dx(), img.Bounds().Dy(); w != chunkSize*tileSize || h != chunkSize*tileSize {
+		t.Errorf("Expected an image of %dx%d, got %dx%d", chunkSize*tileSize, chunkSize*tileSize, w, h)
+	}
+	baked.Image(render)
+	if renders != 1 {
+		t.Errorf("Expected 1 render before invalidating, got %d", renders)
+	}
+
+	baked.Invalidate()
+	if !baked.IsDirty() {
+		t.Error("Expected the cache to be dirty after Invalidate")
+	}
+	if baked.Image(render) != img {
+		t.Error("Expected the image to be reused after Invalidate")
+	}
+	if renders != 2 {
+		t.Errorf("Expected 2 renders after invalidating, got %d", renders)
+	}
+
+	baked.Deallocate()
+	baked.Image(render)
+	if renders != 3 {
+		t.Errorf("Expected 3 renders after Deallocate, got %d", renders)
+	}
+}
@@ -0,0 +1,122 @@
+package world
+
+import (
+	"math"
+
+	"github.com/N3moAhead/harvest/internal/component"
+)
+
+// A tile together with its position in the world
+type PlacedTile struct {
+	*Tile
+	TileX, TileY int
+	Bounds       component.Rect
+}
+
+// IsSolidAt returns true if nothing can move through the tile at the given world position.
+// Everything outside of the map is solid.
+func (w *World) IsSolidAt(pos component.Vector2D) bool {
+	return !w.IsWalkableAt(pos)
+}
+
+// TilesInRect returns all tiles that overlap with the given rect in world coordinates.
+// Tiles outside of the map are skipped.
+func (w *World) TilesInRect(rect component.Rect) []PlacedTile {
+	startX, startY := w.TileCoordinates(component.NewVector2D(rect.X, rect.Y))
+	// Subtracting a tiny bit to not include the next tile if the rect ends exactly on a tile edge
+	endX, endY := w.TileCoordinates(component.NewVector2D(rect.X+rect.Width-1e-9, rect.Y+rect.Height-1e-9))
+
+	tiles := make([]PlacedTile, 0, (endX-startX+1)*(endY-startY+1))
+	for y := startY; y <= endY; y++ {
+		for x := startX; x <= endX; x++ {
+			tile, ok := w.GetTile(x, y)
+			if !ok {
+				continue
+			}
+			tiles = append(tiles, PlacedTile{
+				Tile:   tile,
+				TileX:  x,
+				TileY:  y,
+				Bounds: w.tileBounds(x, y),
+			})
+		}
+	}
+	return tiles
+}
+
+func (w *World) tileBounds(tileX, tileY int) component.Rect {
+	return component.Rect{
+		X:      float64(tileX * w.tileWidth),
+		Y:      float64(tileY * w.tileHeight),
+		Width:  float64(w.tileWidth),
+		Height: float64(w.tileHeight),
+	}
+}
+
+// Returns the bounds of all solid tiles overlapping the rect.
+// Tiles outside of the map count as solid.
+func (w *World) solidTilesInRect(rect component.Rect) []component.Rect {
+	startX, startY := w.TileCoordinates(component.NewVector2D(rect.X, rect.Y))
+	endX, endY := w.TileCoordinates(component.NewVector2D(rect.X+rect.Width-1e-9, rect.Y+rect.Height-1e-9))
+
+	var solids []component.Rect
+	for y := startY; y <= endY; y++ {
+		for x := startX; x <= endX; x++ {
+			tile, ok := w.GetTile(x, y)
+			if !ok || !tile.IsWalkable {
+				solids = append(solids, w.tileBounds(x, y))
+			}
+		}
+	}
+	return solids
+}
+
+// MoveAndSlide moves the box by delta and stops it at solid tiles.
+// The movement is resolved for each axis on its own so a box that runs
+// diagonally into a wall keeps sliding along it.
+// Large movements are split into steps smaller than a tile so fast
+// things like knockbacks can not tunnel through thin walls.
+// A box that already overlaps a solid tile can move freely to be able
+// to leave it again. The returned vector is the distance the box actually moved.
+func (w *World) MoveAndSlide(box component.Rect, delta component.Vector2D) component.Vector2D {
+	if len(w.solidTilesInRect(box)) > 0 {
+		return delta
+	}
+
+	maxStep := float64(min(w.tileWidth, w.tileHeight)) / 2
+	steps := int(math.Ceil(math.Max(math.Abs(delta.X), math.Abs(delta.Y)) / maxStep))
+	if steps == 0 {
+		return component.Vector2D{}
+	}
+	step := delta.Mul(1 / float64(steps))
+
+	start := component.NewVector2D(box.X, box.Y)
+	for range steps {
+		if step.X != 0 {
+			box.X += step.X
+			for _, solid := range w.solidTilesInRect(box) {
+				if step.X > 0 {
+					box.X = math.Min(box.X, solid.X-box.Width)
+				} else {
+					box.X = math.Max(box.X, solid.X+solid.Width)
+				}
+				step.X = 0
+			}
+		}
+		if step.Y != 0 {
+			box.Y += step.Y
+			for _, solid := range w.solidTilesInRect(box) {
+				if step.Y > 0 {
+					box.Y = math.Min(box.Y, solid.Y-box.Height)
+				} else {
+					box.Y = math.Max(box.Y, solid.Y+solid.Height)
+				}
+				step.Y = 0
+			}
+		}
+		if step.X == 0 && step.Y == 0 {
+			break
+		}
+	}
+	return component.NewVector2D(box.X, box.Y).Sub(start)
+}
@@ -0,0 +1,185 @@
+package world
+
+import (
+	"math"
+
+	"github.com/N3moAhead/harvest/internal/component"
+)
+
+const (
+	straightCost = 10 // The cost of walking to a horizontal or vertical neighbour
+	diagonalCost = 14 // The cost of walking to a diagonal neighbour (~10 * sqrt(2))
+	unreachable  = math.MaxInt32
+)
+
+// The 8 neighbours of a tile. The first 4 are the straight ones.
+var neighbourOffsets = [8][2]int{
+	{0, -1}, {1, 0}, {0, 1}, {-1, 0},
+	{1, -1}, {1, 1}, {-1, 1}, {-1, -1},
+}
+
+// FlowField stores for every tile around a target the direction of the
+// shortest walkable path to it. Thousands of enemies can follow the same
+// target by just looking up the tile they stand on. The world is infinite
+// so the field only covers a square window centered on the target.
+// The field only gets recomputed when the target changes its tile.
+type FlowField struct {
+	world      *World
+	radius     int
+	width      int // width and height of the window in tiles
+	height     int
+	originX    int // The tile coordinates of the top left corner of the window
+	originY    int
+	targetX    int // The target in coordinates of the window
+	targetY    int
+	walkable   []bool // Copy of the walkable tiles in the window, the lookup in the chunks is slower
+	distances  []int32
+	directions []component.Vector2D
+	computed   bool
+	buckets    [diagonalCost + 1][]int32 // Reused queue for the dijkstra
+}
+
+func NewFlowField(w *World, radius int) *FlowField {
+	size := 2*radius + 1
+	return &FlowField{
+		world:      w,
+		radius:     radius,
+		width:      size,
+		height:     size,
+		walkable:   make([]bool, size*size),
+		distances:  make([]int32, size*size),
+		directions: make([]component.Vector2D, size*size),
+	}
+}
+
+// Update recomputes the field if the target moved onto another tile.
+// Returns true if the field got recomputed.
+func (f *FlowField) Update(target component.Vector2D) bool {
+	tileX, tileY := f.world.TileCoordinates(target)
+	if f.computed && tileX == f.originX+f.targetX && tileY == f.originY+f.targetY {
+		return false
+	}
+	f.originX, f.originY = tileX-f.radius, tileY-f.radius
+	f.targetX, f.targetY = f.radius, f.radius
+	f.copyWalkable()
+	f.computeDistances()
+	f.computeDirections()
+	f.computed = true
+	return true
+}
+
+// DirectionAt returns the normalized direction an entity at pos has to walk to
+// follow the shortest path to the target. ok is false if there is no path from pos
+// or pos is on the target tile itself, then walking straight to the target is the best option.
+func (f *FlowField) DirectionAt(pos component.Vector2D) (dir component.Vector2D, ok bool) {
+	if !f.computed {
+		return component.Vector2D{}, false
+	}
+	tileX, tileY := f.world.TileCoordinates(pos)
+	tileX -= f.originX
+	tileY -= f.originY
+	if !f.inBounds(tileX, tileY) {
+		return component.Vector2D{}, false
+	}
+	dir = f.directions[tileY*f.width+tileX]
+	return dir, dir.LengthSq() > 0
+}
+
+func (f *FlowField) inBounds(tileX, tileY int) bool {
+	return tileX >= 0 && tileY >= 0 && tileX < f.width && tileY < f.height
+}
+
+func (f *FlowField) copyWalkable() {
+	for y := range f.height {
+		for x := range f.width {
+			tile, ok := f.world.GetTile(f.originX+x, f.originY+y)
+			f.walkable[y*f.width+x] = ok && tile.IsWalkable
+		}
+	}
+}
+
+func (f *FlowField) isWalkable(tileX, tileY int) bool {
+	return f.inBounds(tileX, tileY) && f.walkable[tileY*f.width+tileX]
+}
+
+// Diagonal moves are only allowed if both straight neighbours are walkable.
+// Otherwise enemies would try to cut through the corner of an obstacle.
+func (f *FlowField) canMove(fromX, fromY, dx, dy int) bool {
+	if !f.isWalkable(fromX+dx, fromY+dy) {
+		return false
+	}
+	if dx != 0 && dy != 0 {
+		return f.isWalkable(fromX+dx, fromY) && f.isWalkable(fromX, fromY+dy)
+	}
+	return true
+}
+
+// A dijkstra with a bucket queue. Because all edge costs are small
+// integers it runs in O(tiles) instead of O(tiles * log(tiles)).
+func (f *FlowField) computeDistances() {
+	for i := range f.distances {
+		f.distances[i] = unreachable
+	}
+	for i := range f.buckets {
+		f.buckets[i] = f.buckets[i][:0]
+	}
+
+	start := int32(f.targetY*f.width + f.targetX)
+	f.distances[start] = 0
+	f.buckets[0] = append(f.buckets[0], start)
+	queued := 1
+
+	for dist := int32(0); queued > 0; dist++ {
+		// Edge costs are smaller than the amount of buckets so
+		// no tile gets added to the bucket that is currently processed
+		bucket := &f.buckets[dist%int32(len(f.buckets))]
+		for _, index := range *bucket {
+			queued--
+			if f.distances[index] != dist {
+				continue // There was a shorter path to this tile
+			}
+			x, y := int(index)%f.width, int(index)/f.width
+			for i, offset := range neighbourOffsets {
+				if !f.canMove(x, y, offset[0], offset[1]) {
+					continue
+				}
+				cost := int32(straightCost)
+				if i >= 4 {
+					cost = diagonalCost
+				}
+				neighbour := int32((y+offset[1])*f.width + x + offset[0])
+				if dist+cost < f.distances[neighbour] {
+					f.distances[neighbour] = dist + cost
+					next := &f.buckets[(dist+cost)%int32(len(f.buckets))]
+					*next = append(*next, neighbour)
+					queued++
+				}
+			}
+		}
+		*bucket = (*bucket)[:0]
+	}
+}
+
+// Every tile points to its neighbour with the lowest distance to the target
+func (f *FlowField) computeDirections() {
+	for y := range f.height {
+		for x := range f.width {
+			index := y*f.width + x
+			best := f.distances[index]
+			f.directions[index] = component.Vector2D{}
+			if best == unreachable {
+				continue
+			}
+			for _, offset := range neighbourOffsets {
+				if !f.canMove(x, y, offset[0], offset[1]) {
+					continue
+				}
+				neighbourDist := f.distances[(y+offset[1])*f.width+x+offset[0]]
+				if neighbourDist < best {
+					best = neighbourDist
+					f.directions[index] = component.NewVector2D(float64(offset[0]), float64(offset[1])).Normalize()
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,71 @@
+package world
+
+import (
+	"math"
+	"math/rand"
+)
+
+// noise2D is a seeded value noise. It returns smooth random values
+// in the range [0, 1] for every point of the plane. The same seed
+// always produces the same noise so generated maps are reproducible.
+type noise2D struct {
+	perm   [512]int
+	values [256]float64
+}
+
+func newNoise2D(rng *rand.Rand) *noise2D {
+	n := &noise2D{}
+	for i := range n.values {
+		n.values[i] = rng.Float64()
+	}
+	p := rng.Perm(256)
+	for i := range n.perm {
+		n.perm[i] = p[i%256]
+	}
+	return n
+}
+
+// Returns the random value of the lattice point x, y
+func (n *noise2D) lattice(x, y int) float64 {
+	return n.values[n.perm[(n.perm[x&255]+y)&255]]
+}
+
+// Smoothstep for a nicer transition between the lattice points
+func fade(t float64) float64 {
+	return t * t * (3 - 2*t)
+}
+
+func lerp(a, b, t float64) float64 {
+	return a + (b-a)*t
+}
+
+// at returns the noise value at the given point
+func (n *noise2D) at(x, y float64) float64 {
+	x0 := math.Floor(x)
+	y0 := math.Floor(y)
+	tx := fade(x - x0)
+	ty := fade(y - y0)
+	ix, iy := int(x0), int(y0)
+
+	top := lerp(n.lattice(ix, iy), n.lattice(ix+1, iy), tx)
+	bottom := lerp(n.lattice(ix, iy+1), n.lattice(ix+1, iy+1), tx)
+	return lerp(top, bottom, ty)
+}
+
+// fbm layers multiple octaves of noise on top of each other.
+// Every octave has double the frequency and half the amplitude
+// of the previous one which gives the result more details.
+// The result stays in the range [0, 1].
+func (n *noise2D) fbm(x, y float64, octaves int) float64 {
+	sum := 0.0
+	amplitude := 1.0
+	frequency := 1.0
+	totalAmplitude := 0.0
+	for range octaves {
+		sum += n.at(x*frequency, y*frequency) * amplitude
+		totalAmplitude += amplitude
+		amplitude /= 2
+		frequency *= 2
+	}
+	return sum / totalAmplitude
+}
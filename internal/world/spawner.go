@@ -5,7 +5,7 @@ import (
 	"math/rand"
 
 	"github.com/N3moAhead/harvest/internal/component"
-	"github.com/N3moAhead/harvest/internal/config"
+	"github.com/N3moAhead/harvest/internal/display"
 	"github.com/N3moAhead/harvest/internal/entity/enemy"
 	"github.com/N3moAhead/harvest/internal/entity/player"
 	"github.com/N3moAhead/harvest/pkg/util"
@@ -37,16 +37,18 @@ func (s *EnemySpawner) Spawn(enemyType string, pos component.Vector2D) enemy.Ene
 
 // Random Position
 func (s *EnemySpawner) SpawnRandom(enemyType string) enemy.EnemyInterface {
+	screenWidth, screenHeight := display.Size()
 	pos := component.NewVector2D(
-		rand.Float64()*config.SCREEN_WIDTH,
-		rand.Float64()*config.SCREEN_HEIGHT,
+		rand.Float64()*float64(screenWidth),
+		rand.Float64()*float64(screenHeight),
 	)
 	return s.Spawn(enemyType, pos)
 }
 
 // Random Position within Camera View
 func (s *EnemySpawner) SpawnRandomInView(enemyType string, camX, camY float64) enemy.EnemyInterface {
-	x, y := util.GetRandomPositionInView(camX, camY)
+	viewWidth, viewHeight := display.Size()
+	x, y := util.GetRandomPositionInView(camX, camY, float64(viewWidth), float64(viewHeight))
 	spawnPos := component.NewVector2D(x, y)
 	return s.Spawn(enemyType, spawnPos)
 }
@@ -100,10 +102,11 @@ func (s *EnemySpawner) SpawnLine(enemyType string, start component.Vector2D, cou
 // 4. Random Pattern
 func (s *EnemySpawner) SpawnMoreRandom(count int, enemyType string) []enemy.EnemyInterface {
 	positions := make([]component.Vector2D, count)
+	screenWidth, screenHeight := display.Size()
 
 	for i := 0; i < count; i++ {
-		x := rand.Float64() * config.SCREEN_WIDTH
-		y := rand.Float64() * config.SCREEN_HEIGHT
+		x := rand.Float64() * float64(screenWidth)
+		y := rand.Float64() * float64(screenHeight)
 		positions[i] = component.NewVector2D(x, y)
 	}
 	return s.SpawnAtPositions(enemyType, positions)
@@ -1,6 +1,9 @@
 package world
 
-import "github.com/hajimehoshi/ebiten/v2"
+import (
+	"github.com/N3moAhead/harvest/internal/drawstats"
+	"github.com/hajimehoshi/ebiten/v2"
+)
 
 type TileType int
 
@@ -13,15 +16,49 @@ type Tile struct {
 
 const (
 	GrassMiddle TileType = iota
+	Soil
+	Water
+	Fence
+	GreenhouseWall
+	GreenhouseFloor
+	Rock
+	Imported // Tiles of handcrafted maps
 )
 
+func (t TileType) String() string {
+	switch t {
+	case GrassMiddle:
+		return "GrassMiddle"
+	case Soil:
+		return "Soil"
+	case Water:
+		return "Water"
+	case Fence:
+		return "Fence"
+	case GreenhouseWall:
+		return "GreenhouseWall"
+	case GreenhouseFloor:
+		return "GreenhouseFloor"
+	case Rock:
+		return "Rock"
+	case Imported:
+		return "Imported"
+	default:
+		return "Unknown"
+	}
+}
+
 func (t *Tile) Draw(screen *ebiten.Image, posX float64, posY float64) {
 	op := &ebiten.DrawImageOptions{}
 	op.GeoM.Translate(posX, posY)
+	t.drawWithOptions(screen, op)
+}
+
+func (t *Tile) drawWithOptions(screen *ebiten.Image, op *ebiten.DrawImageOptions) {
 	if t.FloorImage != nil {
-		screen.DrawImage(t.FloorImage, op)
+		drawstats.DrawImage(screen, t.FloorImage, op)
 	}
 	if t.DecorImage != nil {
-		screen.DrawImage(t.DecorImage, op)
+		drawstats.DrawImage(screen, t.DecorImage, op)
 	}
 }
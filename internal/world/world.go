@@ -3,119 +3,266 @@ package world
 import (
 	"math"
 
+	"github.com/N3moAhead/harvest/internal/camera"
 	"github.com/N3moAhead/harvest/internal/component"
 	"github.com/N3moAhead/harvest/internal/config"
+	"github.com/N3moAhead/harvest/internal/drawstats"
 	"github.com/hajimehoshi/ebiten/v2"
 )
 
 type World struct {
-	tiles        [][]Tile
-	tileWidth    int
-	tileHeight   int
-	mapWidthPx   int
-	mapHeightPx  int
-	cameraPos    component.Vector2D // Current camera position
-	cameraTarget component.Vector2D // Target camera position
-	cameraSpeed  float64            // How fast the camera is moving
+	chunks      map[ChunkCoord]*Chunk
+	generator   *generator // Creates new chunks. Not set for handcrafted maps.
+	tileWidth   int
+	tileHeight  int
+	bounded     bool       // Handcrafted maps have a fixed size, generated maps are infinite
+	mapWidthPx  int        // Only set for bounded maps
+	mapHeightPx int        // Only set for bounded maps
+	seed        int64      // The seed the map got generated with
+	flowField   *FlowField // Paths of all tiles to the player
+	arena       *Arena     // Only set for handcrafted maps
+	bakeChunks  bool       // Draw the chunks from pre-rendered images instead of tile by tile
 }
 
-func NewWorld(widthInTiles, heightInTiles int) *World {
-	tileW, tileH := config.TILE_SIZE, config.TILE_SIZE
+// NewWorld creates an infinite generated world. Chunks are created while the
+// player explores the world. The chunks around the origin get loaded right away
+// because thats where the player spawns.
+func NewWorld(seed int64) *World {
+	w := newWorld()
+	w.seed = seed
+	w.generator = newGenerator(seed)
+	w.streamChunks(component.Vector2D{})
+	return w
+}
 
-	tiles := NewMap()
+// Creates a world with a fixed size out of the given tiles.
+// All chunks stay loaded the whole time.
+func newBoundedWorld(tiles [][]Tile) *World {
+	w := newWorld()
+	w.bounded = true
+	heightInTiles := len(tiles)
+	widthInTiles := 0
+	if heightInTiles > 0 {
+		widthInTiles = len(tiles[0])
+	}
+	w.mapWidthPx = widthInTiles * w.tileWidth
+	w.mapHeightPx = heightInTiles * w.tileHeight
+	for y := range tiles {
+		for x := range tiles[y] {
+			coord := chunkCoordOfTile(x, y)
+			chunk, ok := w.chunks[coord]
+			if !ok {
+				// Tiles of the chunk outside of the map stay unwalkable
+				chunk = &Chunk{Coord: coord}
+				w.chunks[coord] = chunk
+			}
+			chunk.tiles[floorMod(y, config.CHUNK_SIZE)][floorMod(x, config.CHUNK_SIZE)] = tiles[y][x]
+		}
+	}
+	return w
+}
 
+func newWorld() *World {
 	m := &World{
-		tiles:        tiles,
-		tileWidth:    tileW,
-		tileHeight:   tileH,
-		mapWidthPx:   widthInTiles * tileW,
-		mapHeightPx:  heightInTiles * tileH,
-		cameraPos:    component.Vector2D{X: 0, Y: 0},
-		cameraTarget: component.Vector2D{X: 0, Y: 0},
-		// The camera speed. The camera is faster as higher this value gets
-		cameraSpeed: config.CAMERA_SPEED,
+		chunks:     make(map[ChunkCoord]*Chunk),
+		tileWidth:  config.TILE_SIZE,
+		tileHeight: config.TILE_SIZE,
+		bakeChunks: true,
 	}
+	m.flowField = NewFlowField(m, config.FLOW_FIELD_RADIUS)
 	return m
 }
 
-func (m *World) Update(targetWorldPos component.Vector2D, screenWidth, screenHeight int, dt float64) {
-	// Calculate the target position of the camera
-	// The camera should keep the player in the center of the screen.
-	// Therefore, the top left corner of the camera = playerPos - half screen size.
-	m.cameraTarget.X = targetWorldPos.X - float64(screenWidth)/2
-	m.cameraTarget.Y = targetWorldPos.Y - float64(screenHeight)/2
-
-	// Clamp the camera position to the map boundaries
-	m.cameraTarget.X = math.Max(0, math.Min(m.cameraTarget.X, float64(m.mapWidthPx-screenWidth)))
-	m.cameraTarget.Y = math.Max(0, math.Min(m.cameraTarget.Y, float64(m.mapHeightPx-screenHeight)))
-
-	// Smoothly move the camera towards the target position (Linear Interpolation - Lerp)
-	// Calculate the vector from the current position to the target position
-	diff := m.cameraTarget.Sub(m.cameraPos)
-	// Move the camera a portion of the way towards the target, depending on speed and dt
-	// A simple Lerp factor would also be possible: factor := 0.1; m.cameraPos = m.cameraPos.Add(diff.Mul(factor))
-	// But using dt makes it frame-independent:
-	moveStep := diff.Mul(m.cameraSpeed * dt)
-
-	moveStepLen := moveStep.Len()
-	// Avoid "overshooting" at high frame rate/speed - move at most the difference
-	if moveStepLen > diff.Len() || moveStepLen < 0.1 {
-		m.cameraPos = m.cameraTarget
-	} else {
-		m.cameraPos = m.cameraPos.Add(moveStep)
-	}
-
-	// For Safety clamp the camera position to the map boundaries again maybe the value came a bit off
-	m.cameraPos.X = math.Max(0, math.Min(m.cameraPos.X, float64(m.mapWidthPx-screenWidth)))
-	m.cameraPos.Y = math.Max(0, math.Min(m.cameraPos.Y, float64(m.mapHeightPx-screenHeight)))
-}
-
-func (m *World) Draw(screen *ebiten.Image) {
-	screenWidth, screenHeight := screen.Bounds().Dx(), screen.Bounds().Dy()
-
-	camX := m.cameraPos.X
-	camY := m.cameraPos.Y
-
-	// Determine the tile indices to draw
-	// The plus 1 is a small buffer to ensure we draw enough tiles
-	startTileX := int(math.Floor(camX/float64(m.tileWidth))) - 1
-	startTileY := int(math.Floor(camY/float64(m.tileHeight))) - 1
-	endTileX := int(math.Ceil((camX+float64(screenWidth))/float64(m.tileWidth))) + 1
-	endTileY := int(math.Ceil((camY+float64(screenHeight))/float64(m.tileHeight))) + 1
-
-	mapTilesWidth := len(m.tiles[0])
-	mapTilesHeight := len(m.tiles)
-	startTileX = max(0, startTileX)
-	startTileY = max(0, startTileY)
-	endTileX = min(mapTilesWidth, endTileX)
-	endTileY = min(mapTilesHeight, endTileY)
-
-	// Drawing the visible tiles
-	for y := startTileY; y < endTileY; y++ {
-		for x := startTileX; x < endTileX; x++ {
-			tile := m.tiles[y][x]
-
-			worldX := float64(x * m.tileWidth)
-			worldY := float64(y * m.tileHeight)
-
-			screenX := float32(worldX - camX)
-			screenY := float32(worldY - camY)
-
-			tile.Draw(screen, float64(screenX), float64(screenY))
-
-			// vector.DrawFilledRect(
-			// 	screen,
-			// 	screenX,
-			// 	screenY,
-			// 	float32(m.tileWidth),
-			// 	float32(m.tileHeight),
-			// 	tileColor,
-			// 	false,
-			// )
+// Update loads the chunks around the focus, which is usually the player
+func (m *World) Update(focus component.Vector2D) {
+	m.streamChunks(focus)
+}
+
+// Bounds returns the area of maps that have a fixed size.
+// ok is false for infinite maps.
+func (m *World) Bounds() (bounds component.Rect, ok bool) {
+	if !m.bounded {
+		return component.Rect{}, false
+	}
+	return component.Rect{Width: float64(m.mapWidthPx), Height: float64(m.mapHeightPx)}, true
+}
+
+// Creates all missing chunks around the center and removes the ones that are far away.
+// Bounded maps are loaded completely so nothing happens for them.
+func (m *World) streamChunks(center component.Vector2D) {
+	if m.generator == nil {
+		return
+	}
+	centerChunk := chunkCoordOfTile(m.TileCoordinates(center))
+	for y := centerChunk.Y - config.CHUNK_LOAD_RADIUS; y <= centerChunk.Y+config.CHUNK_LOAD_RADIUS; y++ {
+		for x := centerChunk.X - config.CHUNK_LOAD_RADIUS; x <= centerChunk.X+config.CHUNK_LOAD_RADIUS; x++ {
+			m.loadChunk(ChunkCoord{x, y})
+		}
+	}
+	for coord := range m.chunks {
+		if max(abs(coord.X-centerChunk.X), abs(coord.Y-centerChunk.Y)) > config.CHUNK_EVICT_RADIUS {
+			m.chunks[coord].unload()
+			delete(m.chunks, coord)
+		}
+	}
+}
+
+// Returns the chunk at the coordinate and generates it if its missing
+func (m *World) loadChunk(coord ChunkCoord) *Chunk {
+	chunk, ok := m.chunks[coord]
+	if !ok && m.generator != nil {
+		chunk = m.generator.generateChunk(coord)
+		m.chunks[coord] = chunk
+	}
+	return chunk
+}
+
+// IsLoadedAt returns true if the chunk at the world position is loaded.
+// Things outside of the loaded chunks can not collide with the map.
+func (w *World) IsLoadedAt(pos component.Vector2D) bool {
+	_, ok := w.GetTile(w.TileCoordinates(pos))
+	return ok
+}
+
+// SetChunkBaking switches between drawing the pre-rendered chunk images
+// and drawing every visible tile on its own. Baking is on by default,
+// turning it off is only useful to compare the performance of both.
+func (m *World) SetChunkBaking(enabled bool) {
+	m.bakeChunks = enabled
+}
+
+// Draw draws the pre-rendered images of all visible chunks.
+// The image of a chunk gets rendered on its first draw and
+// again after one of its tiles changed. Without chunk baking
+// the visible tiles get drawn one by one.
+func (m *World) Draw(screen *ebiten.Image, cam *camera.Camera) {
+	view := cam.ViewRect()
+	camGeoM := cam.GeoM()
+
+	// Determine the tiles and chunks that are visible
+	startTileX, startTileY := m.TileCoordinates(component.NewVector2D(view.X, view.Y))
+	endTileX, endTileY := m.TileCoordinates(component.NewVector2D(view.X+view.Width, view.Y+view.Height))
+	startChunk := chunkCoordOfTile(startTileX, startTileY)
+	endChunk := chunkCoordOfTile(endTileX, endTileY)
+	for cy := startChunk.Y; cy <= endChunk.Y; cy++ {
+		for cx := startChunk.X; cx <= endChunk.X; cx++ {
+			chunk, ok := m.chunks[ChunkCoord{cx, cy}]
+			if !ok {
+				continue
+			}
+			bounds := chunk.Bounds()
+			geoM := ebiten.GeoM{}
+			geoM.Translate(bounds.X, bounds.Y)
+			geoM.Concat(camGeoM)
+			if !m.bakeChunks {
+				// Only the visible part of the chunk gets drawn
+				chunkTileX, chunkTileY := cx*config.CHUNK_SIZE, cy*config.CHUNK_SIZE
+				chunk.drawTiles(screen, geoM,
+					max(0, startTileX-chunkTileX), max(0, startTileY-chunkTileY),
+					min(config.CHUNK_SIZE, endTileX-chunkTileX+1), min(config.CHUNK_SIZE, endTileY-chunkTileY+1))
+				continue
+			}
+			op := &ebiten.DrawImageOptions{GeoM: geoM}
+			drawstats.DrawImage(screen, chunk.image(), op)
 		}
 	}
 }
 
-func (w *World) GetCameraPosition() (float64, float64) {
-	return w.cameraPos.X, w.cameraPos.Y
+// UpdateFlowField points the flow field to the target.
+// Its cheap to call every tick because the field only gets
+// recomputed after the target walked onto another tile.
+func (w *World) UpdateFlowField(target component.Vector2D) {
+	w.flowField.Update(target)
+}
+
+// FlowDirectionAt returns the direction of the shortest path from pos to
+// the target of the flow field. ok is false if there is no path.
+func (w *World) FlowDirectionAt(pos component.Vector2D) (dir component.Vector2D, ok bool) {
+	return w.flowField.DirectionAt(pos)
+}
+
+func (w *World) GetSeed() int64 {
+	return w.seed
+}
+
+// Returns the tile at the given tile coordinates. If the coordinates
+// are outside of the map or the chunk is not loaded ok will be false.
+func (w *World) GetTile(tileX, tileY int) (tile *Tile, ok bool) {
+	if w.bounded && (tileX < 0 || tileY < 0 || tileX*w.tileWidth >= w.mapWidthPx || tileY*w.tileHeight >= w.mapHeightPx) {
+		return nil, false
+	}
+	chunk, ok := w.chunks[chunkCoordOfTile(tileX, tileY)]
+	if !ok {
+		return nil, false
+	}
+	return &chunk.tiles[floorMod(tileY, config.CHUNK_SIZE)][floorMod(tileX, config.CHUNK_SIZE)], true
+}
+
+// SetTile replaces the tile at the given tile coordinates.
+// Returns false if the coordinates are outside of the map or not loaded.
+func (w *World) SetTile(tileX, tileY int, tile Tile) bool {
+	current, ok := w.GetTile(tileX, tileY)
+	if !ok {
+		return false
+	}
+	*current = tile
+	w.chunks[chunkCoordOfTile(tileX, tileY)].invalidate()
+	return true
+}
+
+// Converts a position in the world to the coordinates of the tile at this position
+func (w *World) TileCoordinates(pos component.Vector2D) (tileX, tileY int) {
+	return int(math.Floor(pos.X / float64(w.tileWidth))), int(math.Floor(pos.Y / float64(w.tileHeight)))
+}
+
+// IsWalkableAt returns true if the tile at the given world position can be walked on.
+// Everything outside of the map or the loaded chunks is not walkable.
+func (w *World) IsWalkableAt(pos component.Vector2D) bool {
+	tile, ok := w.GetTile(w.TileCoordinates(pos))
+	return ok && tile.IsWalkable
+}
+
+// FindWalkablePosition returns the center of the closest walkable tile to pos.
+// Its useful to move things that got placed randomly out of obstacles.
+// Missing chunks in the search area get generated.
+// If there is no walkable tile in range pos is returned unchanged.
+func (w *World) FindWalkablePosition(pos component.Vector2D) component.Vector2D {
+	const maxSearchRadius = 32
+	startX, startY := w.TileCoordinates(pos)
+	from := chunkCoordOfTile(startX-maxSearchRadius, startY-maxSearchRadius)
+	to := chunkCoordOfTile(startX+maxSearchRadius, startY+maxSearchRadius)
+	for cy := from.Y; cy <= to.Y; cy++ {
+		for cx := from.X; cx <= to.X; cx++ {
+			w.loadChunk(ChunkCoord{cx, cy})
+		}
+	}
+
+	if w.IsWalkableAt(pos) {
+		return pos
+	}
+	for radius := 1; radius <= maxSearchRadius; radius++ {
+		for dy := -radius; dy <= radius; dy++ {
+			for dx := -radius; dx <= radius; dx++ {
+				// Only check the outer ring of the current radius
+				if max(abs(dx), abs(dy)) != radius {
+					continue
+				}
+				tile, ok := w.GetTile(startX+dx, startY+dy)
+				if ok && tile.IsWalkable {
+					return component.NewVector2D(
+						(float64(startX+dx)+0.5)*float64(w.tileWidth),
+						(float64(startY+dy)+0.5)*float64(w.tileHeight),
+					)
+				}
+			}
+		}
+	}
+	return pos
+}
+
+func abs(v int) int {
+	if v < 0 {
+		return -v
+	}
+	return v
 }
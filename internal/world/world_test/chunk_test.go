@@ -0,0 +1,77 @@
+package world_test
+
+import (
+	"testing"
+
+	"github.com/N3moAhead/harvest/internal/component"
+	"github.com/N3moAhead/harvest/internal/config"
+	"github.com/N3moAhead/harvest/internal/world"
+)
+
+const chunkSizePx = float64(config.CHUNK_SIZE * config.TILE_SIZE)
+
+// Returns the center of the chunk in world coordinates
+func chunkCenter(chunkX, chunkY int) component.Vector2D {
+	return component.NewVector2D((float64(chunkX)+0.5)*chunkSizePx, (float64(chunkY)+0.5)*chunkSizePx)
+}
+
+func TestTilesAtNegativeCoordinates(t *testing.T) {
+	w := world.NewWorld(1)
+	// Tiles on both sides of the chunk borders around the origin
+	tileXs := []int{-config.CHUNK_SIZE - 1, -config.CHUNK_SIZE, -1, 0, config.CHUNK_SIZE - 1, config.CHUNK_SIZE}
+	for i, x := range tileXs {
+		if !w.SetTile(x, -1, world.Tile{Type: world.TileType(i)}) {
+			t.Fatalf("Expected the tile %d, -1 to be loaded", x)
+		}
+	}
+	// Every tile has its own place in its chunk
+	for i, x := range tileXs {
+		tile, ok := w.GetTile(x, -1)
+		if !ok || tile.Type != world.TileType(i) {
+			t.Errorf("Expected the tile %d, -1 to be %v, got %v", x, world.TileType(i), tile)
+		}
+	}
+}
+
+func TestChunksAroundNegativeFocus(t *testing.T) {
+	w := world.NewWorld(1)
+	// A position just left of and above the origin is in the chunk -1, -1
+	w.Update(component.NewVector2D(-1, -1))
+	radius := config.CHUNK_LOAD_RADIUS
+	if !w.IsLoadedAt(chunkCenter(-1-radius, -1-radius)) {
+		t.Error("Expected the chunks around the chunk -1, -1 to be loaded")
+	}
+	if w.IsLoadedAt(chunkCenter(-2-radius, -1)) {
+		t.Error("Expected no chunk further away than the load radius to be loaded")
+	}
+}
+
+func TestChunksGetEvicted(t *testing.T) {
+	w := world.NewWorld(1)
+	// The chunks from -2 to 2 are loaded around the spawn. Moving away
+	// keeps the ones in the evict radius and removes the others.
+	focus := config.CHUNK_LOAD_RADIUS + config.CHUNK_EVICT_RADIUS
+	w.Update(chunkCenter(focus, 0))
+
+	tests := []struct {
+		chunkX int
+		loaded bool
+	}{
+		{-config.CHUNK_LOAD_RADIUS, false},
+		{focus - config.CHUNK_EVICT_RADIUS - 1, false},
+		{focus - config.CHUNK_EVICT_RADIUS, true}, // Loaded at the spawn and still in the evict radius
+		{focus + config.CHUNK_LOAD_RADIUS, true},
+		{focus + config.CHUNK_LOAD_RADIUS + 1, false},
+	}
+	for _, tt := range tests {
+		if loaded := w.IsLoadedAt(chunkCenter(tt.chunkX, 0)); loaded != tt.loaded {
+			t.Errorf("Expected the chunk %d, 0 to be loaded: %v, got %v", tt.chunkX, tt.loaded, loaded)
+		}
+	}
+
+	// Coming back creates the removed chunks again
+	w.Update(component.Vector2D{})
+	if !w.IsLoadedAt(chunkCenter(-config.CHUNK_LOAD_RADIUS, 0)) {
+		t.Error("Expected the chunks around the spawn to be loaded again")
+	}
+}
@@ -0,0 +1,123 @@
+package world_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/N3moAhead/harvest/internal/component"
+	"github.com/N3moAhead/harvest/internal/config"
+	"github.com/N3moAhead/harvest/internal/world"
+)
+
+const tileSize = float64(config.TILE_SIZE)
+
+// Creates a world with walls on the given tiles. The tiles around
+// the origin are the spawn area which is always walkable, so the
+// tests can place their walls there without other obstacles.
+func newWorldWithWalls(t *testing.T, walls ...[2]int) *world.World {
+	t.Helper()
+	w := world.NewWorld(1)
+	for y := -8; y <= 8; y++ {
+		for x := -8; x <= 8; x++ {
+			if x*x+y*y <= 8*8 {
+				w.SetTile(x, y, world.Tile{Type: world.GrassMiddle, IsWalkable: true})
+			}
+		}
+	}
+	for _, wall := range walls {
+		if !w.SetTile(wall[0], wall[1], world.Tile{Type: world.Fence, IsWalkable: false}) {
+			t.Fatalf("Could not place a wall at %d, %d", wall[0], wall[1])
+		}
+	}
+	return w
+}
+
+// A vertical wall at the tile column x from y = -3 to 3
+func verticalWall(x int) [][2]int {
+	var walls [][2]int
+	for y := -3; y <= 3; y++ {
+		walls = append(walls, [2]int{x, y})
+	}
+	return walls
+}
+
+func assertMoved(t *testing.T, got, want component.Vector2D) {
+	t.Helper()
+	if math.Abs(got.X-want.X) > 1e-9 || math.Abs(got.Y-want.Y) > 1e-9 {
+		t.Errorf("Expected to move by %v, got %v", want, got)
+	}
+}
+
+func TestMoveAndSlideAlongWall(t *testing.T) {
+	w := newWorldWithWalls(t, verticalWall(3)...)
+	// The box ends 4 pixels before the wall at x = 48
+	box := component.Rect{X: 36, Y: 0, Width: 8, Height: 8}
+	moved := w.MoveAndSlide(box, component.NewVector2D(10, 10))
+	assertMoved(t, moved, component.NewVector2D(4, 10))
+}
+
+func TestMoveAndSlideDoesNotTunnel(t *testing.T) {
+	w := newWorldWithWalls(t, verticalWall(3)...)
+	// A knockback that is many times larger than the wall is thick
+	box := component.Rect{X: 20, Y: 0, Width: 8, Height: 8}
+	moved := w.MoveAndSlide(box, component.NewVector2D(10*tileSize, 0))
+	assertMoved(t, moved, component.NewVector2D(3*tileSize-28, 0))
+
+	// The same from the other side
+	box = component.Rect{X: 4 * tileSize, Y: 0, Width: 8, Height: 8}
+	moved = w.MoveAndSlide(box, component.NewVector2D(-10*tileSize, 0))
+	assertMoved(t, moved, component.NewVector2D(0, 0))
+}
+
+func TestMoveAndSlideLeavesOverlappingTiles(t *testing.T) {
+	w := newWorldWithWalls(t, verticalWall(3)...)
+	// The box is stuck inside of the wall
+	box := component.Rect{X: 3*tileSize + 2, Y: 0, Width: 8, Height: 8}
+	delta := component.NewVector2D(5, -3)
+	moved := w.MoveAndSlide(box, delta)
+	assertMoved(t, moved, delta)
+}
+
+func TestMoveAndSlideWithoutDelta(t *testing.T) {
+	w := newWorldWithWalls(t)
+	moved := w.MoveAndSlide(component.Rect{Width: 8, Height: 8}, component.Vector2D{})
+	assertMoved(t, moved, component.Vector2D{})
+}
+
+func TestTilesInRectAtNegativeCoordinates(t *testing.T) {
+	w := newWorldWithWalls(t)
+	tiles := w.TilesInRect(component.Rect{X: -20, Y: -20, Width: 16, Height: 16})
+	if len(tiles) != 4 {
+		t.Fatalf("Expected 4 tiles, got %d", len(tiles))
+	}
+	for _, tile := range tiles {
+		if tile.TileX < -2 || tile.TileX > -1 || tile.TileY < -2 || tile.TileY > -1 {
+			t.Errorf("Unexpected tile %d, %d", tile.TileX, tile.TileY)
+		}
+		want := component.Rect{X: float64(tile.TileX) * tileSize, Y: float64(tile.TileY) * tileSize, Width: tileSize, Height: tileSize}
+		if tile.Bounds != want {
+			t.Errorf("Expected the bounds %+v for the tile %d, %d, got %+v", want, tile.TileX, tile.TileY, tile.Bounds)
+		}
+	}
+
+	// A rect that ends exactly on a tile edge does not include the next tile
+	tiles = w.TilesInRect(component.Rect{X: -tileSize, Y: -tileSize, Width: tileSize, Height: tileSize})
+	if len(tiles) != 1 || tiles[0].TileX != -1 || tiles[0].TileY != -1 {
+		t.Errorf("Expected only the tile -1, -1, got %+v", tiles)
+	}
+}
+
+func TestIsSolidAt(t *testing.T) {
+	w := newWorldWithWalls(t, [2]int{-2, 1})
+	if !w.IsSolidAt(component.NewVector2D(-2*tileSize+1, tileSize+1)) {
+		t.Error("Expected the wall to be solid")
+	}
+	if w.IsSolidAt(component.NewVector2D(-tileSize+1, tileSize+1)) {
+		t.Error("Expected the grass next to the wall to not be solid")
+	}
+	// Chunks that are not loaded count as solid
+	far := float64(100 * config.CHUNK_SIZE * config.TILE_SIZE)
+	if !w.IsSolidAt(component.NewVector2D(far, far)) {
+		t.Error("Expected a position outside of the loaded chunks to be solid")
+	}
+}
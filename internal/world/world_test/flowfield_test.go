@@ -0,0 +1,98 @@
+package world_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/N3moAhead/harvest/internal/component"
+	"github.com/N3moAhead/harvest/internal/world"
+)
+
+// The window of the field stays inside of the walkable spawn area
+const fieldRadius = 6
+
+// Returns the center of the tile in world coordinates
+func tileCenter(tileX, tileY int) component.Vector2D {
+	return component.NewVector2D((float64(tileX)+0.5)*tileSize, (float64(tileY)+0.5)*tileSize)
+}
+
+func TestFlowFieldLeadsAroundWall(t *testing.T) {
+	w := newWorldWithWalls(t, verticalWall(2)...)
+	field := world.NewFlowField(w, fieldRadius)
+	field.Update(tileCenter(0, 0))
+
+	// Right next to the target the direction points straight to it
+	dir, ok := field.DirectionAt(tileCenter(1, 0))
+	if !ok || dir != component.NewVector2D(-1, 0) {
+		t.Errorf("Expected to walk left next to the target, got %v, %v", dir, ok)
+	}
+
+	// Behind the wall the direction has to go around it
+	dir, ok = field.DirectionAt(tileCenter(4, 0))
+	if !ok {
+		t.Fatal("Expected a path from behind the wall")
+	}
+	if dir.Y == 0 {
+		t.Errorf("Expected to walk around the wall, got %v", dir)
+	}
+
+	// Following the directions reaches the target without walking into the wall
+	x, y := 4, 0
+	for steps := 0; x != 0 || y != 0; steps++ {
+		if steps > 4*fieldRadius {
+			t.Fatalf("Expected to reach the target, stuck at %d, %d", x, y)
+		}
+		dir, ok := field.DirectionAt(tileCenter(x, y))
+		if !ok {
+			t.Fatalf("Expected a direction at %d, %d", x, y)
+		}
+		// The directions point to one of the 8 neighbours
+		x += int(math.Round(dir.X / math.Max(math.Abs(dir.X), math.Abs(dir.Y))))
+		y += int(math.Round(dir.Y / math.Max(math.Abs(dir.X), math.Abs(dir.Y))))
+		if w.IsSolidAt(tileCenter(x, y)) {
+			t.Fatalf("Expected the path to avoid the wall, walked onto %d, %d", x, y)
+		}
+	}
+}
+
+func TestFlowFieldUnreachablePocket(t *testing.T) {
+	// The tile -4, 0 is enclosed by walls on all sides
+	var walls [][2]int
+	for y := -1; y <= 1; y++ {
+		for x := -5; x <= -3; x++ {
+			if x != -4 || y != 0 {
+				walls = append(walls, [2]int{x, y})
+			}
+		}
+	}
+	w := newWorldWithWalls(t, walls...)
+	field := world.NewFlowField(w, fieldRadius)
+	field.Update(tileCenter(0, 0))
+
+	dir, ok := field.DirectionAt(tileCenter(-4, 0))
+	if ok || dir != (component.Vector2D{}) {
+		t.Errorf("Expected no direction inside of the pocket, got %v, %v", dir, ok)
+	}
+	// Outside of the pocket there is still a path
+	if _, ok := field.DirectionAt(tileCenter(-6, 0)); !ok {
+		t.Error("Expected a path around the pocket")
+	}
+}
+
+func TestFlowFieldOnlyRecomputesOnAnotherTile(t *testing.T) {
+	w := newWorldWithWalls(t)
+	field := world.NewFlowField(w, fieldRadius)
+	if !field.Update(tileCenter(0, 0)) {
+		t.Error("Expected the first update to compute the field")
+	}
+	// Moving inside of the same tile keeps the field
+	if field.Update(component.NewVector2D(1, 1)) || field.Update(component.NewVector2D(tileSize-1, tileSize-1)) {
+		t.Error("Expected no recompute while the target stays on its tile")
+	}
+	if !field.Update(tileCenter(1, 0)) {
+		t.Error("Expected a recompute after the target moved onto another tile")
+	}
+	if field.Update(tileCenter(1, 0)) {
+		t.Error("Expected no recompute for the same tile again")
+	}
+}
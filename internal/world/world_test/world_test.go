@@ -0,0 +1,52 @@
+package world_test
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/N3moAhead/harvest/internal/assets"
+	"github.com/N3moAhead/harvest/internal/camera"
+	"github.com/N3moAhead/harvest/internal/config"
+	"github.com/N3moAhead/harvest/internal/world"
+	"github.com/hajimehoshi/ebiten/v2"
+)
+
+var loadImages sync.Once
+
+// Loads the images of all assets. The tile images have
+// to be loaded before the world gets created.
+func loadTileImages(b *testing.B) {
+	loadImages.Do(func() {
+		manifest, err := assets.LoadManifest(assets.FS)
+		if err != nil {
+			b.Fatal(err)
+		}
+		images := manifest.Filter(func(entry assets.ManifestEntry) bool { return entry.Type == assets.ImageAsset })
+		if err := assets.AssetStore.Load(assets.FS, images, config.AUDIO_SAMPLE_RATE); err != nil {
+			b.Fatal(err)
+		}
+	})
+}
+
+// Draws the generated world around the spawn like the game scene does
+func benchmarkDraw(b *testing.B, bakeChunks bool) {
+	loadTileImages(b)
+	w := world.NewWorld(1)
+	w.SetChunkBaking(bakeChunks)
+	cam := camera.NewCamera(config.SCREEN_WIDTH, config.SCREEN_HEIGHT)
+	screen := ebiten.NewImage(config.SCREEN_WIDTH, config.SCREEN_HEIGHT)
+	// The first draw renders the chunk images
+	w.Draw(screen, cam)
+	b.ResetTimer()
+	for range b.N {
+		w.Draw(screen, cam)
+	}
+}
+
+func BenchmarkDrawPerTile(b *testing.B) {
+	benchmarkDraw(b, false)
+}
+
+func BenchmarkDrawBaked(b *testing.B) {
+	benchmarkDraw(b, true)
+}
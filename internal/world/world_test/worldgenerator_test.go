@@ -0,0 +1,61 @@
+package world_test
+
+import (
+	"testing"
+
+	"github.com/N3moAhead/harvest/internal/config"
+	"github.com/N3moAhead/harvest/internal/world"
+)
+
+// The radius in tiles around the origin that is compared. The chunks
+// in it are loaded by NewWorld because the player spawns there.
+const compareRadius = config.CHUNK_LOAD_RADIUS * config.CHUNK_SIZE
+
+func TestSameSeedGeneratesTheSameWorld(t *testing.T) {
+	a := world.NewWorld(42)
+	b := world.NewWorld(42)
+	for y := -compareRadius; y < compareRadius; y++ {
+		for x := -compareRadius; x < compareRadius; x++ {
+			tileA, okA := a.GetTile(x, y)
+			tileB, okB := b.GetTile(x, y)
+			if !okA || !okB {
+				t.Fatalf("Expected the tile %d, %d to be loaded", x, y)
+			}
+			if *tileA != *tileB {
+				t.Fatalf("Expected the same tile at %d, %d, got %v and %v", x, y, tileA.Type, tileB.Type)
+			}
+		}
+	}
+}
+
+func TestOtherSeedGeneratesAnotherWorld(t *testing.T) {
+	a := world.NewWorld(42)
+	b := world.NewWorld(43)
+	differences := 0
+	for y := -compareRadius; y < compareRadius; y++ {
+		for x := -compareRadius; x < compareRadius; x++ {
+			tileA, _ := a.GetTile(x, y)
+			tileB, _ := b.GetTile(x, y)
+			if tileA.Type != tileB.Type {
+				differences++
+			}
+		}
+	}
+	if differences == 0 {
+		t.Error("Expected another seed to change the tiles")
+	}
+}
+
+func TestSpawnAreaIsWalkable(t *testing.T) {
+	for _, seed := range []int64{1, 2, 3} {
+		w := world.NewWorld(seed)
+		for y := -5; y <= 5; y++ {
+			for x := -5; x <= 5; x++ {
+				tile, ok := w.GetTile(x, y)
+				if !ok || !tile.IsWalkable {
+					t.Errorf("Seed %d: expected the spawn tile %d, %d to be walkable", seed, x, y)
+				}
+			}
+		}
+	}
+}
@@ -10,50 +10,307 @@ import (
 )
 
 const (
-	flowerAmount = int((config.HEIGHT_IN_TILES * config.WIDTH_IN_TILES) * 0.08)
+	// --- Biomes ---
+	biomeNoiseScale = 1.0 / 28.0 // Lower values result in larger biomes
+	biomeOctaves    = 4
+	waterLevel      = 0.3  // Every tile with an elevation below this value is water
+	soilLevel       = 0.64 // Every tile with a fertility above this value is soil
+	// --- Structures ---
+	spawnClearRadius      = 10   // The radius in tiles around the origin that stays free of obstacles
+	greenhouseDensity     = 3500 // One greenhouse attempt per this many tiles
+	greenhouseMinSize     = 7
+	greenhouseMaxSize     = 12
+	fenceDensity          = 900 // One fence attempt per this many tiles
+	fenceMinLength        = 4
+	fenceMaxLength        = 12
+	decorDensity          = 0.08  // The share of grass tiles that get a flower or a bush
+	rockDensity           = 0.006 // The share of grass tiles that get a rock on them
+	maxStructurePlacement = 20    // How often a structure tries to find a free spot
 )
 
-func NewMap() [][]Tile {
-	tiles := make([][]Tile, config.HEIGHT_IN_TILES)
-
-	grassMiddle1 := loadTileImage("tf_grass_middle")
-	grassMiddle2 := loadTileImage("tf_grass_middle2")
+// All images the generator needs to build a map
+type tileImages struct {
+	grass           [2]*ebiten.Image
+	soil            [3][3]*ebiten.Image // Rows and cols of the soil autotile
+	water           *ebiten.Image
+	fenceHorizontal *ebiten.Image
+	fenceVertical   *ebiten.Image
+	greenhouseWall  *ebiten.Image
+	greenhouseFloor *ebiten.Image
+	plants          []*ebiten.Image
+	rocks           []*ebiten.Image
+}
 
-	// Create a splice of all grass images
-	plants := []*ebiten.Image{}
+func loadTileImages() *tileImages {
+	images := &tileImages{
+		grass:           [2]*ebiten.Image{loadTileImage("tf_grass_middle"), loadTileImage("tf_grass_middle2")},
+		water:           loadTileImage("tf_water_middle"),
+		fenceHorizontal: loadTileImage("td_fence_h"),
+		fenceVertical:   loadTileImage("td_fence_v"),
+		greenhouseWall:  loadTileImage("td_greenhouse_wall"),
+		greenhouseFloor: loadTileImage("tf_greenhouse_floor"),
+	}
+	for row := range 3 {
+		for col := range 3 {
+			images.soil[row][col] = loadTileImage(fmt.Sprintf("tf_soil_%d_%d", row, col))
+		}
+	}
 
+	// The last 3 decor tiles of the sprite are rocks which are used as obstacles.
+	// Everything else are plants.
 	cols, rows := 7, 4
 	for row := range rows {
 		for col := range cols {
+			name := "td_decor_" + fmt.Sprint(row) + "_" + fmt.Sprint(col)
 			if row == 3 && col >= 4 {
+				images.rocks = append(images.rocks, loadTileImage(name))
+			} else {
+				images.plants = append(images.plants, loadTileImage(name))
+			}
+		}
+	}
+	return images
+}
+
+// The generator creates the chunks of an infinite map from a seed.
+// Every chunk only depends on the seed and its coordinates, so chunks
+// can be created in any order and a chunk created twice looks the same.
+type generator struct {
+	seed      int64
+	elevation *noise2D
+	fertility *noise2D
+	images    *tileImages
+	// The state of the chunk that is currently generated
+	rng   *rand.Rand
+	chunk *Chunk
+}
+
+func newGenerator(seed int64) *generator {
+	rng := rand.New(rand.NewSource(seed))
+	return &generator{
+		seed:      seed,
+		elevation: newNoise2D(rng),
+		fertility: newNoise2D(rng),
+		images:    loadTileImages(),
+	}
+}
+
+// generateChunk creates a chunk with biomes (fields, soil patches, water),
+// obstacles (fences, greenhouses, rocks) and decoration.
+// The area around the origin of the map is always kept walkable
+// because its the spawn position of the player.
+func (g *generator) generateChunk(coord ChunkCoord) *Chunk {
+	g.chunk = &Chunk{Coord: coord}
+	g.rng = rand.New(rand.NewSource(g.chunkSeed(coord)))
+	g.generateBiomes()
+	g.placeGreenhouses()
+	g.placeFences()
+	g.placeDecor()
+	g.applySoilAutotiles()
+	chunk := g.chunk
+	g.chunk = nil
+	return chunk
+}
+
+// Mixes the coordinates of the chunk into the seed of the map
+func (g *generator) chunkSeed(coord ChunkCoord) int64 {
+	h := uint64(g.seed)
+	h ^= uint64(int64(coord.X)) * 0x9E3779B97F4A7C15
+	h ^= uint64(int64(coord.Y)) * 0xC2B2AE3D27D4EB4F
+	h ^= h >> 29
+	return int64(h)
+}
+
+// Converts chunk local tile coordinates to global tile coordinates
+func (g *generator) global(x, y int) (int, int) {
+	return g.chunk.Coord.X*config.CHUNK_SIZE + x, g.chunk.Coord.Y*config.CHUNK_SIZE + y
+}
+
+// The amount of placement attempts for a structure with the given density.
+// Chunks can be smaller than the density so the remainder is used as a chance.
+func (g *generator) attempts(density int) int {
+	tiles := float64(config.CHUNK_SIZE * config.CHUNK_SIZE)
+	amount := int(tiles / float64(density))
+	if g.rng.Float64() < tiles/float64(density)-float64(amount) {
+		amount++
+	}
+	return amount
+}
+
+func (g *generator) generateBiomes() {
+	for y := range config.CHUNK_SIZE {
+		for x := range config.CHUNK_SIZE {
+			gx, gy := g.global(x, y)
+			switch {
+			case isInSpawnArea(gx, gy):
+				g.chunk.tiles[y][x] = g.newGrassTile(gx, gy)
+			case g.isWater(gx, gy):
+				g.chunk.tiles[y][x] = Tile{Type: Water, FloorImage: g.images.water, IsWalkable: false}
+			case g.isSoil(gx, gy):
+				// The correct image gets set after all soil tiles are known
+				g.chunk.tiles[y][x] = Tile{Type: Soil, IsWalkable: true}
+			default:
+				g.chunk.tiles[y][x] = g.newGrassTile(gx, gy)
+			}
+		}
+	}
+}
+
+func (g *generator) isWater(gx, gy int) bool {
+	return g.elevation.fbm(float64(gx)*biomeNoiseScale, float64(gy)*biomeNoiseScale, biomeOctaves) < waterLevel
+}
+
+// Soil only depends on the noise and not on the chunk. This way the
+// autotiles also fit at the border to the neighbouring chunks.
+func (g *generator) isSoil(gx, gy int) bool {
+	if isInSpawnArea(gx, gy) || g.isWater(gx, gy) {
+		return false
+	}
+	return g.fertility.fbm(float64(gx)*biomeNoiseScale, float64(gy)*biomeNoiseScale, biomeOctaves) > soilLevel
+}
+
+func (g *generator) newGrassTile(gx, gy int) Tile {
+	return Tile{
+		Type:       GrassMiddle,
+		FloorImage: g.images.grass[abs(gx+gy)%2],
+		IsWalkable: true,
+	}
+}
+
+func isInSpawnArea(gx, gy int) bool {
+	return gx*gx+gy*gy <= spawnClearRadius*spawnClearRadius
+}
+
+// Returns true if every tile of the rect is plain grass without
+// any decoration and the rect is outside of the spawn area.
+// Structures have to fit into a single chunk.
+func (g *generator) isFreeGrass(x, y, w, h int) bool {
+	if x < 0 || y < 0 || x+w > config.CHUNK_SIZE || y+h > config.CHUNK_SIZE {
+		return false
+	}
+	for ty := y; ty < y+h; ty++ {
+		for tx := x; tx < x+w; tx++ {
+			tile := g.chunk.tiles[ty][tx]
+			if tile.Type != GrassMiddle || tile.DecorImage != nil || isInSpawnArea(g.global(tx, ty)) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// Greenhouses are rectangles of glass walls with a
+// walkable floor inside and a door in the bottom wall
+func (g *generator) placeGreenhouses() {
+	for range g.attempts(greenhouseDensity) {
+		for range maxStructurePlacement {
+			w := greenhouseMinSize + g.rng.Intn(greenhouseMaxSize-greenhouseMinSize+1)
+			h := greenhouseMinSize + g.rng.Intn(greenhouseMaxSize-greenhouseMinSize+1)
+			x := g.rng.Intn(config.CHUNK_SIZE)
+			y := g.rng.Intn(config.CHUNK_SIZE)
+			if !g.isFreeGrass(x, y, w, h) {
 				continue
 			}
-			name := "td_decor_" + fmt.Sprint(row) + "_" + fmt.Sprint(col)
-			plantImage := loadTileImage(name)
-			plants = append(plants, plantImage)
+			doorX := x + 1 + g.rng.Intn(w-3)
+			for ty := y; ty < y+h; ty++ {
+				for tx := x; tx < x+w; tx++ {
+					isWall := tx == x || tx == x+w-1 || ty == y || ty == y+h-1
+					isDoor := ty == y+h-1 && (tx == doorX || tx == doorX+1)
+					if isWall && !isDoor {
+						g.chunk.tiles[ty][tx] = Tile{
+							Type:       GreenhouseWall,
+							FloorImage: g.images.greenhouseFloor,
+							DecorImage: g.images.greenhouseWall,
+							IsWalkable: false,
+						}
+					} else {
+						g.chunk.tiles[ty][tx] = Tile{
+							Type:       GreenhouseFloor,
+							FloorImage: g.images.greenhouseFloor,
+							IsWalkable: true,
+						}
+					}
+				}
+			}
+			break
 		}
 	}
+}
 
-	for y := range tiles {
-		tiles[y] = make([]Tile, config.WIDTH_IN_TILES)
-		for x := range tiles[y] {
-			var floorImage *ebiten.Image = grassMiddle1
-			if (y+x)%2 == 0 {
-				floorImage = grassMiddle2
+// Fences are straight horizontal or vertical lines on the grass
+func (g *generator) placeFences() {
+	for range g.attempts(fenceDensity) {
+		for range maxStructurePlacement {
+			length := fenceMinLength + g.rng.Intn(fenceMaxLength-fenceMinLength+1)
+			horizontal := g.rng.Intn(2) == 0
+			x := g.rng.Intn(config.CHUNK_SIZE)
+			y := g.rng.Intn(config.CHUNK_SIZE)
+			w, h := 1, length
+			fenceImage := g.images.fenceVertical
+			if horizontal {
+				w, h = length, 1
+				fenceImage = g.images.fenceHorizontal
+			}
+			if !g.isFreeGrass(x, y, w, h) {
+				continue
 			}
-			tiles[y][x] = Tile{
-				Type:       GrassMiddle,
-				FloorImage: floorImage,
-				IsWalkable: true,
+			for ty := y; ty < y+h; ty++ {
+				for tx := x; tx < x+w; tx++ {
+					g.chunk.tiles[ty][tx].Type = Fence
+					g.chunk.tiles[ty][tx].DecorImage = fenceImage
+					g.chunk.tiles[ty][tx].IsWalkable = false
+				}
 			}
+			break
 		}
 	}
+}
 
-	for range flowerAmount {
-		tiles[rand.Intn(config.HEIGHT_IN_TILES)][rand.Intn(config.WIDTH_IN_TILES)].DecorImage = plants[rand.Intn(len(plants))]
+// Scatters plants and rocks over the grass.
+// Plants are only decoration, rocks are obstacles.
+func (g *generator) placeDecor() {
+	for y := range config.CHUNK_SIZE {
+		for x := range config.CHUNK_SIZE {
+			tile := &g.chunk.tiles[y][x]
+			if tile.Type != GrassMiddle || tile.DecorImage != nil {
+				continue
+			}
+			roll := g.rng.Float64()
+			switch {
+			case roll < rockDensity && !isInSpawnArea(g.global(x, y)) && len(g.images.rocks) > 0:
+				tile.Type = Rock
+				tile.DecorImage = g.images.rocks[g.rng.Intn(len(g.images.rocks))]
+				tile.IsWalkable = false
+			case roll < rockDensity+decorDensity && len(g.images.plants) > 0:
+				tile.DecorImage = g.images.plants[g.rng.Intn(len(g.images.plants))]
+			}
+		}
 	}
+}
 
-	return tiles
+// Soil patches use a 3x3 autotile. Tiles on the border of a
+// patch get an edge or corner image depending on their neighbours.
+func (g *generator) applySoilAutotiles() {
+	for y := range config.CHUNK_SIZE {
+		for x := range config.CHUNK_SIZE {
+			if g.chunk.tiles[y][x].Type != Soil {
+				continue
+			}
+			gx, gy := g.global(x, y)
+			row, col := 1, 1
+			if !g.isSoil(gx, gy-1) {
+				row = 0
+			} else if !g.isSoil(gx, gy+1) {
+				row = 2
+			}
+			if !g.isSoil(gx-1, gy) {
+				col = 0
+			} else if !g.isSoil(gx+1, gy) {
+				col = 2
+			}
+			g.chunk.tiles[y][x].FloorImage = g.images.soil[row][col]
+		}
+	}
 }
 
 func loadTileImage(name string) *ebiten.Image {
@@ -73,16 +73,21 @@ func (btn *Button) Draw(screen *ebiten.Image) {
 	btn.BaseElement.Draw(screen)
 }
 
+// Activate clicks the button
+func (btn *Button) Activate() {
+	if btn.OnClick != nil {
+		btn.OnClick()
+	}
+}
+
 func (btn *Button) HandleInput(input *InputState) {
 	if !btn.Visible || !btn.Enabled {
 		return
 	}
 
 	if btn.IsMouseOver(input.MouseX, input.MouseY) && input.MouseButtonLeftPressed {
-		if btn.OnClick != nil {
-			btn.OnClick()
-		}
+		btn.Activate()
 	}
 }
 
-var _ UIElement = (*Button)(nil)
+var _ Focusable = (*Button)(nil)
@@ -1,5 +1,7 @@
 package ui
 
+import "math"
+
 type ContainerDirection string
 
 const (
@@ -11,14 +13,26 @@ const (
 type ContainerOptions struct {
 	Direction ContainerDirection
 	Gap       float64
+	Padding   float64   // The space between the border of the container and its children
+	Align     Alignment // Where the children are placed across the direction
+	Justify   Alignment // Where the children are placed along the direction if there is space left
+	// A fixed size of the container. Sizes of 0 make the
+	// container exactly as large as its children.
+	Width, Height float64
 }
 
 type Container struct {
 	BaseElement
 	direction ContainerDirection
 	gap       float64
-	xOffset   float64
-	yOffset   float64
+	padding   float64
+	align     Alignment
+	justify   Alignment
+	fixedSize [2]float64
+	// How much of the space that is left each child gets
+	// and the size the child had before it got resized
+	grow      []float64
+	baseSizes [][2]float64
 }
 
 func NewContainer(x, y float64, op *ContainerOptions) *Container {
@@ -27,64 +41,134 @@ func NewContainer(x, y float64, op *ContainerOptions) *Container {
 		op.Direction = Row
 	}
 	return &Container{
-		// Width and height will be defined as zero
+		// Width and height are calculated by the layout
 		BaseElement: *NewBaseElement(x, y, 0, 0),
 		direction:   op.Direction,
 		gap:         op.Gap,
+		padding:     op.Padding,
+		align:       op.Align,
+		justify:     op.Justify,
+		fixedSize:   [2]float64{op.Width, op.Height},
 	}
 }
 
-func (c *Container) getNextPosition(numExistingChildren int) (float64, float64) {
-	offsetX, offsetY := 0.0, 0.0
+// AddChild places the children based on the config
+// beside or below each other, with a defined gap between them
+func (c *Container) AddChild(newChild UIElement) {
+	c.AddFlexChild(newChild, 0)
+}
 
-	totalGapOffset := float64(numExistingChildren) * c.gap
+// AddFlexChild adds a child that grows along the direction of the
+// container to fill the space that is left. The space is shared between
+// the flexible children by their grow factor. Only children that
+// implement Resizable can grow.
+func (c *Container) AddFlexChild(newChild UIElement, grow float64) {
+	width, height := newChild.GetSize()
+	c.BaseElement.AddChild(newChild)
+	c.grow = append(c.grow, grow)
+	c.baseSizes = append(c.baseSizes, [2]float64{width, height})
+	c.Layout()
+}
 
+// The index of the axis along the direction of the container
+func (c *Container) mainAxis() int {
 	if c.direction == Row {
-		currentTotalWidth := 0.0
-		for i, existingChild := range c.Children {
-			// If set position is called on an container we
-			// can only count some not all elements
-			if i >= numExistingChildren {
-				break
-			}
-			width, _ := existingChild.GetSize()
-			currentTotalWidth += width
+		return 0
+	}
+	return 1
+}
+
+// The size a child is measured with. Children that get resized by the
+// layout start from the size they had when they were added so they can
+// shrink again.
+func (c *Container) childSize(i int, child UIElement, main int) [2]float64 {
+	width, height := child.GetSize()
+	size := [2]float64{width, height}
+	_, resizable := child.(Resizable)
+	if resizable && c.grow[i] > 0 {
+		size[main] = c.baseSizes[i][main]
+	}
+	if resizable && c.align == AlignStretch {
+		size[1-main] = c.baseSizes[i][1-main]
+	}
+	return size
+}
+
+// Layout sizes the container and places its children. Nested
+// containers are laid out first so their size is known.
+func (c *Container) Layout() {
+	main := c.mainAxis()
+	cross := 1 - main
+
+	sizes := make([][2]float64, len(c.Children))
+	var content [2]float64
+	totalGrow := 0.0
+	for i, child := range c.Children {
+		if layouter, ok := child.(Layouter); ok {
+			layouter.Layout()
 		}
-		offsetX = currentTotalWidth + totalGapOffset
-	} else {
-		currentTotalHeight := 0.0
-		for i, existingChild := range c.Children {
-			if i >= numExistingChildren {
-				break
-			}
-			_, height := existingChild.GetSize()
-			currentTotalHeight += height
+		sizes[i] = c.childSize(i, child, main)
+		content[main] += sizes[i][main]
+		content[cross] = math.Max(content[cross], sizes[i][cross])
+		if _, ok := child.(Resizable); ok {
+			totalGrow += c.grow[i]
+		}
+	}
+	if len(c.Children) > 1 {
+		content[main] += float64(len(c.Children)-1) * c.gap
+	}
+
+	var size [2]float64
+	for axis := range size {
+		size[axis] = c.fixedSize[axis]
+		if size[axis] <= 0 {
+			size[axis] = content[axis] + 2*c.padding
 		}
-		offsetY = currentTotalHeight + totalGapOffset
 	}
+	c.Width, c.Height = size[0], size[1]
 
-	posX := c.X + offsetX
-	posY := c.Y + offsetY
+	// Space that is left is given to growing children or used to justify them
+	free := math.Max(0, size[main]-2*c.padding-content[main])
+	var position [2]float64
+	position[main] = c.padding
+	if totalGrow == 0 {
+		position[main] += free * c.justify.factor()
+	}
+	innerCross := size[cross] - 2*c.padding
 
-	return posX, posY
-}
+	for i, child := range c.Children {
+		childSize := sizes[i]
+		resizable, isResizable := child.(Resizable)
+		if isResizable && totalGrow > 0 && c.grow[i] > 0 {
+			childSize[main] += free * c.grow[i] / totalGrow
+		}
+		if isResizable && c.align == AlignStretch {
+			childSize[cross] = innerCross
+		}
+		if width, height := child.GetSize(); isResizable && childSize != [2]float64{width, height} {
+			resizable.SetSize(childSize[0], childSize[1])
+		}
 
-// AddChild places the children based on the config
-// beside or below each other, with a defined gap between them
-func (c *Container) AddChild(newChild UIElement) {
-	numExistingChildren := len(c.Children)
-	posX, posY := c.getNextPosition(numExistingChildren)
-	newChild.SetPosition(posX, posY)
-	c.BaseElement.AddChild(newChild)
+		position[cross] = c.padding + (innerCross-childSize[cross])*c.align.factor()
+		child.SetPosition(c.X+position[0], c.Y+position[1])
+		position[main] += childSize[main] + c.gap
+	}
 }
 
 func (c *Container) SetPosition(x, y float64) {
 	c.X = x
 	c.Y = y
-	for i, child := range c.Children {
-		posX, posY := c.getNextPosition(i)
-		child.SetPosition(posX, posY)
-	}
+	c.Layout()
+}
+
+// SetSize gives the container a fixed size
+func (c *Container) SetSize(width, height float64) {
+	c.fixedSize = [2]float64{width, height}
+	c.Layout()
 }
 
-var _ UIElement = (*Container)(nil)
+var (
+	_ UIElement = (*Container)(nil)
+	_ Layouter  = (*Container)(nil)
+	_ Resizable = (*Container)(nil)
+)
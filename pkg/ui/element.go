@@ -6,7 +6,16 @@ import (
 
 type InputState struct {
 	MouseX, MouseY         int
-	MouseButtonLeftPressed bool
+	MouseButtonLeftPressed bool      // Only true in the tick the button got pressed
+	MouseButtonLeftDown    bool      // True as long as the button is held down
+	Confirm                bool      // The confirm action of the keyboard or a gamepad was pressed in this tick
+	Cancel                 bool      // The cancel action of the keyboard or a gamepad was pressed in this tick
+	MoveX, MoveY           float64   // The move vector of the keys or a gamepad. It moves the focus.
+	FocusNext              bool      // Moves the focus to the next element in the tab order
+	FocusPrevious          bool      // Moves the focus to the previous element in the tab order
+	WheelY                 float64   // Positive when the mouse wheel is scrolled up
+	Chars                  []rune    // The characters typed in this tick, including the ones of an IME
+	EditKeys               []EditKey // The keys that edit text pressed or repeated in this tick
 }
 
 type UIElement interface {
@@ -56,6 +65,11 @@ func (b *BaseElement) GetSize() (float64, float64) {
 	return b.Width, b.Height
 }
 
+func (b *BaseElement) SetSize(width, height float64) {
+	b.Width = width
+	b.Height = height
+}
+
 func (b *BaseElement) SetVisible(visible bool) {
 	b.Visible = visible
 }
@@ -117,4 +131,7 @@ func (b *BaseElement) IsMouseOver(mouseX, mouseY int) bool {
 }
 
 // Check that BaseElement correctly implements UIElement
-var _ UIElement = (*BaseElement)(nil)
+var (
+	_ UIElement = (*BaseElement)(nil)
+	_ Resizable = (*BaseElement)(nil)
+)
@@ -0,0 +1,114 @@
+package ui
+
+import "math"
+
+// Focusable elements can be focused and activated without a mouse.
+// The UIManager moves the focus between them with the keyboard or a gamepad.
+type Focusable interface {
+	UIElement
+	// Activate does what a click on the element does
+	Activate()
+}
+
+// Adjustable elements change their value when left or right is pressed
+// while they are focused instead of moving the focus.
+type Adjustable interface {
+	Focusable
+	// Adjust changes the value by the given amount of steps
+	Adjust(steps int)
+}
+
+// Editable elements take the keyboard while they are edited.
+// The focus does not move during that time.
+type Editable interface {
+	IsEditing() bool
+}
+
+// Implemented by elements that only show a part of their children
+type scrollable interface {
+	ScrollIntoView(child UIElement)
+}
+
+// Scrolls every scrollable element that contains the target so the target
+// can be seen. Returns whether the target is one of the given elements
+// or one of their children.
+func scrollIntoView(elements []UIElement, target UIElement) bool {
+	for _, element := range elements {
+		if element == target {
+			return true
+		}
+		if scrollIntoView(element.GetChildren(), target) {
+			if s, ok := element.(scrollable); ok {
+				s.ScrollIntoView(target)
+			}
+			return true
+		}
+	}
+	return false
+}
+
+// How far a stick has to be tilted before it moves the focus
+const navigationThreshold = 0.5
+
+// Converts a move vector to the direction of the axis it points along the most
+func navigationDirection(x, y float64) (dx, dy int) {
+	if math.Abs(x) < navigationThreshold && math.Abs(y) < navigationThreshold {
+		return 0, 0
+	}
+	if math.Abs(x) > math.Abs(y) {
+		if x > 0 {
+			return 1, 0
+		}
+		return -1, 0
+	}
+	if y > 0 {
+		return 0, 1
+	}
+	return 0, -1
+}
+
+// Appends every visible and enabled focusable element in the order
+// they were added. That order is also the tab order.
+func appendFocusables(focusables []Focusable, elements []UIElement) []Focusable {
+	for _, element := range elements {
+		if !element.IsVisible() || !element.IsEnabled() {
+			continue
+		}
+		if focusable, ok := element.(Focusable); ok {
+			focusables = append(focusables, focusable)
+		}
+		focusables = appendFocusables(focusables, element.GetChildren())
+	}
+	return focusables
+}
+
+func center(element UIElement) (x, y float64) {
+	x, y = element.GetPosition()
+	width, height := element.GetSize()
+	return x + width/2, y + height/2
+}
+
+// Finds the element that is closest to the current one in the given
+// direction. Elements that are off to the side count as further away
+// so moving down a column does not jump into the column next to it.
+func nextInDirection(current Focusable, focusables []Focusable, dx, dy int) Focusable {
+	currentX, currentY := center(current)
+	var best Focusable
+	bestScore := math.Inf(1)
+	for _, candidate := range focusables {
+		if candidate == current {
+			continue
+		}
+		x, y := center(candidate)
+		along := (x-currentX)*float64(dx) + (y-currentY)*float64(dy)
+		if along <= 0 {
+			continue
+		}
+		across := math.Abs((x-currentX)*float64(dy)) + math.Abs((y-currentY)*float64(dx))
+		if score := along + 2*across; score < bestScore {
+			best = candidate
+			bestScore = score
+		}
+	}
+	return best
+}
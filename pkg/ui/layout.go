@@ -0,0 +1,66 @@
+package ui
+
+// Anchor is the point of the screen an element is placed relative to
+type Anchor int
+
+const (
+	AnchorTopLeft Anchor = iota
+	AnchorTop
+	AnchorTopRight
+	AnchorLeft
+	AnchorCenter
+	AnchorRight
+	AnchorBottomLeft
+	AnchorBottom
+	AnchorBottomRight
+)
+
+// Returns how far along each axis of the free space the anchor sits.
+// 0 is the left or top edge, 1 the right or bottom edge.
+func (a Anchor) factors() (x, y float64) {
+	return float64(a%3) / 2, float64(a/3) / 2
+}
+
+// Place returns the position of an element of the given size inside of
+// an area of the given size. The offset is added afterwards, so elements
+// anchored to the right or the bottom need a negative offset for a margin.
+func (a Anchor) Place(areaWidth, areaHeight, width, height, offsetX, offsetY float64) (x, y float64) {
+	factorX, factorY := a.factors()
+	return (areaWidth-width)*factorX + offsetX, (areaHeight-height)*factorY + offsetY
+}
+
+// Alignment decides where children are placed inside of the space of a container
+type Alignment int
+
+const (
+	AlignStart Alignment = iota
+	AlignCenter
+	AlignEnd
+	// The children are as large as the container across its direction.
+	// Only used by ContainerOptions.Align.
+	AlignStretch
+)
+
+// Returns how much of the free space is put in front of a child
+func (a Alignment) factor() float64 {
+	switch a {
+	case AlignCenter:
+		return 0.5
+	case AlignEnd:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// Layouter is implemented by elements that place their children.
+// Layout is called again whenever the position or the size of the
+// element or of the screen changes.
+type Layouter interface {
+	Layout()
+}
+
+// Resizable is implemented by elements that can be resized by a container
+type Resizable interface {
+	SetSize(width, height float64)
+}
@@ -1,28 +1,103 @@
 package ui
 
 import (
+	"image/color"
+	"slices"
+
 	"github.com/hajimehoshi/ebiten/v2"
-	"github.com/hajimehoshi/ebiten/v2/inpututil"
+	"github.com/hajimehoshi/ebiten/v2/vector"
 )
 
+// The position of an element relative to the screen
+type anchoring struct {
+	anchor           Anchor
+	offsetX, offsetY float64
+	fill             bool // Resizable elements get the size of the screen
+}
+
 type UIManager struct {
 	elements []UIElement
+	anchors  map[UIElement]anchoring
+	// The size of the area the anchored elements are placed in
+	screenWidth, screenHeight float64
+	// The element that is activated by the confirm action. It is nil until
+	// the keyboard or a gamepad is used to navigate and after mouse clicks.
+	focused    Focusable
+	FocusColor color.Color
+	// The direction of the last tick so holding a direction only moves the focus once
+	lastNavX, lastNavY int
+	// Reused every tick to avoid allocations
+	focusables []Focusable
 }
 
-func NewUIManager() *UIManager {
+// NewUIManager creates a manager that places anchored
+// elements on a screen of the given size
+func NewUIManager(screenWidth, screenHeight float64) *UIManager {
 	return &UIManager{
-		elements: make([]UIElement, 0),
+		elements:     make([]UIElement, 0),
+		anchors:      make(map[UIElement]anchoring),
+		screenWidth:  screenWidth,
+		screenHeight: screenHeight,
+		FocusColor:   color.RGBA{R: 240, G: 200, B: 80, A: 255},
 	}
 }
 
 func (m *UIManager) AddElement(element UIElement) {
 	m.elements = append(m.elements, element)
+	m.layoutElement(element)
+}
+
+// AddAnchoredElement adds an element that stays at the anchor when the
+// screen size changes. The offset is added to the anchored position.
+func (m *UIManager) AddAnchoredElement(element UIElement, anchor Anchor, offsetX, offsetY float64) {
+	m.anchors[element] = anchoring{anchor: anchor, offsetX: offsetX, offsetY: offsetY}
+	m.AddElement(element)
+}
+
+// AddFullscreenElement adds an element that covers the whole screen.
+// Resizable elements are resized whenever the screen size changes.
+func (m *UIManager) AddFullscreenElement(element UIElement) {
+	m.anchors[element] = anchoring{anchor: AnchorTopLeft, fill: true}
+	m.AddElement(element)
+}
+
+// SetScreenSize changes the size of the area the anchored elements are
+// placed in and lays out every element again
+func (m *UIManager) SetScreenSize(width, height float64) {
+	if width == m.screenWidth && height == m.screenHeight {
+		return
+	}
+	m.screenWidth, m.screenHeight = width, height
+	m.Layout()
+}
+
+// Layout runs the layout of every element. Containers first size
+// themselves, then anchored elements are placed on the screen.
+func (m *UIManager) Layout() {
+	for _, element := range m.elements {
+		m.layoutElement(element)
+	}
+}
+
+func (m *UIManager) layoutElement(element UIElement) {
+	a, anchored := m.anchors[element]
+	if resizable, ok := element.(Resizable); ok && a.fill {
+		resizable.SetSize(m.screenWidth, m.screenHeight)
+	}
+	if layouter, ok := element.(Layouter); ok {
+		layouter.Layout()
+	}
+	if anchored {
+		width, height := element.GetSize()
+		element.SetPosition(a.anchor.Place(m.screenWidth, m.screenHeight, width, height, a.offsetX, a.offsetY))
+	}
 }
 
 func (m *UIManager) RemoveElement(element UIElement) {
 	for i, e := range m.elements {
 		if e == element {
 			m.elements = append(m.elements[:i], m.elements[i+1:]...)
+			delete(m.anchors, element)
 			return
 		}
 	}
@@ -30,15 +105,14 @@ func (m *UIManager) RemoveElement(element UIElement) {
 
 func (m *UIManager) ClearElements() {
 	m.elements = make([]UIElement, 0)
+	m.anchors = make(map[UIElement]anchoring)
 }
 
-func (m *UIManager) Update() {
-	mouseX, mouseY := ebiten.CursorPosition()
-	inputState := &InputState{
-		MouseX:                 mouseX,
-		MouseY:                 mouseY,
-		MouseButtonLeftPressed: inpututil.IsMouseButtonJustPressed(ebiten.MouseButtonLeft),
-	}
+// UpdateWithInput updates the elements and the focus with the given input
+func (m *UIManager) UpdateWithInput(inputState *InputState) {
+	// Checked before the elements get the input so the Enter that
+	// ends an edit does not activate the text input again
+	editing := m.isEditing()
 
 	for i := len(m.elements) - 1; i >= 0; i-- {
 		el := m.elements[i]
@@ -53,6 +127,83 @@ func (m *UIManager) Update() {
 			el.HandleInput(inputState)
 		}
 	}
+
+	m.updateFocus(inputState, editing)
+}
+
+// Focused returns the focused element or nil if no element is focused
+func (m *UIManager) Focused() Focusable {
+	return m.focused
+}
+
+// SetFocus focuses the given element. nil removes the focus.
+func (m *UIManager) SetFocus(element Focusable) {
+	m.focused = element
+}
+
+// Text inputs need every key while they are edited so the focus stays where it is
+func (m *UIManager) isEditing() bool {
+	for _, focusable := range m.focusables {
+		if editable, ok := focusable.(Editable); ok && editable.IsEditing() {
+			return true
+		}
+	}
+	return false
+}
+
+func (m *UIManager) updateFocus(inputState *InputState, editing bool) {
+	m.focusables = appendFocusables(m.focusables[:0], m.elements)
+	// Hidden, disabled and removed elements lose the focus
+	if m.focused != nil && !slices.Contains(m.focusables, m.focused) {
+		m.focused = nil
+	}
+	// Mouse users do not need the focus ring
+	if inputState.MouseButtonLeftPressed {
+		m.focused = nil
+	}
+
+	navX, navY := navigationDirection(inputState.MoveX, inputState.MoveY)
+	if navX == m.lastNavX && navY == m.lastNavY {
+		navX, navY = 0, 0
+	} else {
+		m.lastNavX, m.lastNavY = navX, navY
+	}
+	if editing {
+		return
+	}
+	navigating := navX != 0 || navY != 0 || inputState.FocusNext || inputState.FocusPrevious
+	if len(m.focusables) == 0 || (!navigating && !inputState.Confirm) {
+		return
+	}
+
+	// The first input only shows where the focus starts
+	if m.focused == nil {
+		m.focused = m.focusables[0]
+		scrollIntoView(m.elements, m.focused)
+		return
+	}
+
+	switch {
+	case inputState.Confirm:
+		m.focused.Activate()
+	case inputState.FocusNext || inputState.FocusPrevious:
+		i := slices.Index(m.focusables, m.focused)
+		if inputState.FocusNext {
+			i = (i + 1) % len(m.focusables)
+		} else {
+			i = (i - 1 + len(m.focusables)) % len(m.focusables)
+		}
+		m.focused = m.focusables[i]
+	default:
+		if adjustable, ok := m.focused.(Adjustable); ok && navX != 0 {
+			adjustable.Adjust(navX)
+			return
+		}
+		if next := nextInDirection(m.focused, m.focusables, navX, navY); next != nil {
+			m.focused = next
+		}
+	}
+	scrollIntoView(m.elements, m.focused)
 }
 
 func (m *UIManager) Draw(screen *ebiten.Image) {
@@ -61,4 +212,12 @@ func (m *UIManager) Draw(screen *ebiten.Image) {
 			element.Draw(screen)
 		}
 	}
+
+	// The ring is drawn around the focused element with a small margin
+	if m.focused != nil {
+		const margin = 3
+		x, y := m.focused.GetPosition()
+		width, height := m.focused.GetSize()
+		vector.StrokeRect(screen, float32(x-margin), float32(y-margin), float32(width+2*margin), float32(height+2*margin), 2, m.FocusColor, false)
+	}
 }
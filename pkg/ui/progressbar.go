@@ -0,0 +1,67 @@
+package ui
+
+import (
+	"image/color"
+	"math"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/text"
+	"github.com/hajimehoshi/ebiten/v2/vector"
+	"golang.org/x/image/font"
+)
+
+// A ProgressBar fills up from the left the closer Value gets to Max.
+// It is used for health, cooking progress and everything else that fills up.
+type ProgressBar struct {
+	BaseElement
+	Value, Max      float64
+	Label           string // Drawn centered on top of the bar if a font is set
+	Font            font.Face
+	TextColor       color.Color
+	FillColor       color.Color
+	BackgroundColor color.Color
+	BorderColor     color.Color // No border is drawn if it is nil
+}
+
+func NewProgressBar(x, y, width, height, value, max float64) *ProgressBar {
+	return &ProgressBar{
+		BaseElement:     *NewBaseElement(x, y, width, height),
+		Value:           value,
+		Max:             max,
+		TextColor:       color.RGBA{R: 255, G: 255, B: 255, A: 255},
+		FillColor:       color.RGBA{R: 110, G: 160, B: 80, A: 255},
+		BackgroundColor: color.RGBA{R: 50, G: 50, B: 50, A: 255},
+		BorderColor:     color.RGBA{R: 220, G: 220, B: 220, A: 255},
+	}
+}
+
+// Ratio returns how full the bar is as a number between 0 and 1
+func (p *ProgressBar) Ratio() float64 {
+	if p.Max <= 0 {
+		return 0
+	}
+	return math.Max(0, math.Min(p.Value/p.Max, 1))
+}
+
+func (p *ProgressBar) Draw(screen *ebiten.Image) {
+	if !p.Visible {
+		return
+	}
+
+	vector.DrawFilledRect(screen, float32(p.X), float32(p.Y), float32(p.Width), float32(p.Height), p.BackgroundColor, false)
+	vector.DrawFilledRect(screen, float32(p.X), float32(p.Y), float32(p.Width*p.Ratio()), float32(p.Height), p.FillColor, false)
+	if p.BorderColor != nil {
+		vector.StrokeRect(screen, float32(p.X), float32(p.Y), float32(p.Width), float32(p.Height), 1, p.BorderColor, false)
+	}
+
+	if p.Label != "" && p.Font != nil {
+		bounds := text.BoundString(p.Font, p.Label)
+		textX := p.X + (p.Width-float64(bounds.Dx()))/2 - float64(bounds.Min.X)
+		textY := p.Y + (p.Height-float64(bounds.Dy()))/2 - float64(bounds.Min.Y)
+		text.Draw(screen, p.Label, p.Font, int(textX), int(textY), p.TextColor)
+	}
+
+	p.BaseElement.Draw(screen)
+}
+
+var _ UIElement = (*ProgressBar)(nil)
@@ -0,0 +1,177 @@
+package ui
+
+import (
+	"image"
+	"image/color"
+	"math"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/vector"
+)
+
+// The width of the bar that shows how far the list is scrolled
+const scrollBarWidth = 4
+
+// A ScrollList shows its children below each other and cuts off the ones
+// that do not fit. The mouse wheel scrolls the list while the mouse is
+// over it and focused children are scrolled into view.
+type ScrollList struct {
+	BaseElement
+	Gap           float64
+	ScrollSpeed   float64 // Pixels scrolled per step of the mouse wheel
+	BarColor      color.Color
+	offset        float64 // How far the list is scrolled down
+	contentHeight float64
+}
+
+func NewScrollList(x, y, width, height, gap float64) *ScrollList {
+	return &ScrollList{
+		BaseElement: *NewBaseElement(x, y, width, height),
+		Gap:         gap,
+		ScrollSpeed: 30,
+		BarColor:    color.RGBA{R: 220, G: 220, B: 220, A: 160},
+	}
+}
+
+func (l *ScrollList) AddChild(child UIElement) {
+	l.BaseElement.AddChild(child)
+	l.Layout()
+}
+
+// ClearChildren removes every child and scrolls back to the top
+func (l *ScrollList) ClearChildren() {
+	l.Children = make([]UIElement, 0)
+	l.offset = 0
+	l.Layout()
+}
+
+// Offset returns how many pixels the list is scrolled down
+func (l *ScrollList) Offset() float64 {
+	return l.offset
+}
+
+// MaxOffset returns how far the list can be scrolled down
+func (l *ScrollList) MaxOffset() float64 {
+	return math.Max(0, l.contentHeight-l.Height)
+}
+
+// ScrollTo scrolls to the given offset. It is kept between 0 and MaxOffset.
+func (l *ScrollList) ScrollTo(offset float64) {
+	l.offset = offset
+	l.Layout()
+}
+
+// ScrollIntoView scrolls as little as possible to show the whole child
+func (l *ScrollList) ScrollIntoView(child UIElement) {
+	_, y := child.GetPosition()
+	_, height := child.GetSize()
+	if y < l.Y {
+		l.ScrollTo(l.offset - (l.Y - y))
+	} else if y+height > l.Y+l.Height {
+		l.ScrollTo(l.offset + (y + height - l.Y - l.Height))
+	}
+}
+
+// Layout places the children below each other, moved up by the offset
+func (l *ScrollList) Layout() {
+	l.contentHeight = 0
+	for i, child := range l.Children {
+		if layouter, ok := child.(Layouter); ok {
+			layouter.Layout()
+		}
+		_, height := child.GetSize()
+		l.contentHeight += height
+		if i > 0 {
+			l.contentHeight += l.Gap
+		}
+	}
+	l.offset = math.Max(0, math.Min(l.offset, l.MaxOffset()))
+
+	y := l.Y - l.offset
+	for _, child := range l.Children {
+		child.SetPosition(l.X, y)
+		_, height := child.GetSize()
+		y += height + l.Gap
+	}
+}
+
+func (l *ScrollList) SetPosition(x, y float64) {
+	l.X = x
+	l.Y = y
+	l.Layout()
+}
+
+func (l *ScrollList) SetSize(width, height float64) {
+	l.Width = width
+	l.Height = height
+	l.Layout()
+}
+
+// Whether any part of the child can be seen
+func (l *ScrollList) isShown(child UIElement) bool {
+	_, y := child.GetPosition()
+	_, height := child.GetSize()
+	return y+height > l.Y && y < l.Y+l.Height
+}
+
+func (l *ScrollList) Update(input *InputState) {
+	if !l.Visible || !l.Enabled {
+		return
+	}
+	for _, child := range l.Children {
+		if l.isShown(child) {
+			child.Update(input)
+		}
+	}
+}
+
+func (l *ScrollList) HandleInput(input *InputState) {
+	if !l.Visible || !l.Enabled {
+		return
+	}
+
+	mouseOver := l.IsMouseOver(input.MouseX, input.MouseY)
+	if mouseOver && input.WheelY != 0 {
+		l.ScrollTo(l.offset - input.WheelY*l.ScrollSpeed)
+	}
+
+	// Parts of children that are cut off can not be clicked
+	childInput := input
+	if !mouseOver && input.MouseButtonLeftPressed {
+		withoutClick := *input
+		withoutClick.MouseButtonLeftPressed = false
+		childInput = &withoutClick
+	}
+	for _, child := range l.Children {
+		if l.isShown(child) {
+			child.HandleInput(childInput)
+		}
+	}
+}
+
+func (l *ScrollList) Draw(screen *ebiten.Image) {
+	if !l.Visible {
+		return
+	}
+
+	// Drawing on a sub-image of the screen cuts off everything outside of the list
+	clipped := screen.SubImage(image.Rect(int(l.X), int(l.Y), int(l.X+l.Width), int(l.Y+l.Height))).(*ebiten.Image)
+	for _, child := range l.Children {
+		if l.isShown(child) {
+			child.Draw(clipped)
+		}
+	}
+
+	if maxOffset := l.MaxOffset(); maxOffset > 0 {
+		barHeight := l.Height * l.Height / l.contentHeight
+		barY := l.Y + (l.Height-barHeight)*l.offset/maxOffset
+		vector.DrawFilledRect(screen, float32(l.X+l.Width-scrollBarWidth), float32(barY), scrollBarWidth, float32(barHeight), l.BarColor, false)
+	}
+}
+
+var (
+	_ UIElement  = (*ScrollList)(nil)
+	_ Layouter   = (*ScrollList)(nil)
+	_ Resizable  = (*ScrollList)(nil)
+	_ scrollable = (*ScrollList)(nil)
+)
@@ -0,0 +1,175 @@
+package ui
+
+import (
+	"fmt"
+	"image/color"
+	"math"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/text"
+	"github.com/hajimehoshi/ebiten/v2/vector"
+	"golang.org/x/image/font"
+)
+
+// The share of the width of sliders and toggles used by their label
+const labelShare = 0.5
+
+// A Slider lets the user pick a value between Min and Max by dragging
+// the knob. The label is drawn on the left and the track on the right.
+type Slider struct {
+	BaseElement
+	Label      string
+	Font       font.Face
+	Min, Max   float64
+	Value      float64
+	Step       float64 // The value snaps to multiples of the step. 0 disables snapping.
+	Format     func(value float64) string
+	OnChange   func(value float64)
+	TextColor  color.Color
+	TrackColor color.Color
+	FillColor  color.Color
+	KnobColor  color.Color
+	dragging   bool
+	isHovered  bool
+}
+
+func NewSlider(x, y, width, height float64, label string, fnt font.Face, min, max, value float64, onChange func(value float64)) *Slider {
+	slider := &Slider{
+		BaseElement: *NewBaseElement(x, y, width, height),
+		Label:       label,
+		Font:        fnt,
+		Min:         min,
+		Max:         max,
+		TextColor:   color.RGBA{R: 255, G: 255, B: 255, A: 255},
+		TrackColor:  color.RGBA{R: 50, G: 50, B: 50, A: 255},
+		FillColor:   color.RGBA{R: 110, G: 160, B: 80, A: 255},
+		KnobColor:   color.RGBA{R: 220, G: 220, B: 220, A: 255},
+		OnChange:    onChange,
+	}
+	slider.Value = slider.clamp(value)
+	return slider
+}
+
+// The area of the track the knob moves on
+func (s *Slider) trackBounds() (x, y, width, height float64) {
+	x = s.X + s.Width*labelShare
+	width = s.Width * (1 - labelShare)
+	height = s.Height / 3
+	y = s.Y + (s.Height-height)/2
+	return x, y, width, height
+}
+
+// The value as a number between 0 and 1
+func (s *Slider) ratio() float64 {
+	if s.Max == s.Min {
+		return 0
+	}
+	return (s.Value - s.Min) / (s.Max - s.Min)
+}
+
+func (s *Slider) clamp(value float64) float64 {
+	if s.Step > 0 {
+		value = s.Min + math.Round((value-s.Min)/s.Step)*s.Step
+	}
+	return math.Max(s.Min, math.Min(value, s.Max))
+}
+
+// SetValue changes the value and calls OnChange if it changed
+func (s *Slider) SetValue(value float64) {
+	value = s.clamp(value)
+	if value == s.Value {
+		return
+	}
+	s.Value = value
+	if s.OnChange != nil {
+		s.OnChange(value)
+	}
+}
+
+// The amount of steps between Min and Max when Step is not set
+const defaultAdjustSteps = 20
+
+// Adjust moves the value by the given amount of steps
+func (s *Slider) Adjust(steps int) {
+	step := s.Step
+	if step <= 0 {
+		step = (s.Max - s.Min) / defaultAdjustSteps
+	}
+	s.SetValue(s.Value + float64(steps)*step)
+}
+
+// Activate does nothing. Sliders are changed with Adjust.
+func (s *Slider) Activate() {}
+
+// IsDragging returns true while the knob is held with the mouse
+func (s *Slider) IsDragging() bool {
+	return s.dragging
+}
+
+func (s *Slider) Update(input *InputState) {
+	if !s.Visible || !s.Enabled {
+		s.isHovered = false
+		s.dragging = false
+		return
+	}
+	s.isHovered = s.IsMouseOver(input.MouseX, input.MouseY)
+	s.BaseElement.Update(input)
+}
+
+func (s *Slider) Draw(screen *ebiten.Image) {
+	if !s.Visible {
+		return
+	}
+
+	if s.Font != nil {
+		label := s.Label + ": " + s.formatValue()
+		bounds := text.BoundString(s.Font, label)
+		textY := s.Y + (s.Height-float64(bounds.Dy()))/2 - float64(bounds.Min.Y)
+		text.Draw(screen, label, s.Font, int(s.X), int(textY), s.TextColor)
+	}
+
+	trackX, trackY, trackWidth, trackHeight := s.trackBounds()
+	vector.DrawFilledRect(screen, float32(trackX), float32(trackY), float32(trackWidth), float32(trackHeight), s.TrackColor, false)
+	vector.DrawFilledRect(screen, float32(trackX), float32(trackY), float32(trackWidth*s.ratio()), float32(trackHeight), s.FillColor, false)
+
+	knobRadius := s.Height / 3
+	if s.isHovered || s.dragging {
+		knobRadius *= 1.2
+	}
+	knobColor := s.KnobColor
+	if !s.Enabled {
+		knobColor = color.RGBA{R: 90, G: 90, B: 90, A: 255}
+	}
+	vector.DrawFilledCircle(screen, float32(trackX+trackWidth*s.ratio()), float32(trackY+trackHeight/2), float32(knobRadius), knobColor, true)
+
+	s.BaseElement.Draw(screen)
+}
+
+func (s *Slider) formatValue() string {
+	if s.Format != nil {
+		return s.Format(s.Value)
+	}
+	return fmt.Sprintf("%d%%", int(math.Round(s.ratio()*100)))
+}
+
+// The knob follows the mouse as long as the button is held down
+// after it got pressed on the slider
+func (s *Slider) HandleInput(input *InputState) {
+	if !s.Visible || !s.Enabled {
+		return
+	}
+
+	if input.MouseButtonLeftPressed && s.IsMouseOver(input.MouseX, input.MouseY) {
+		s.dragging = true
+	}
+	if !input.MouseButtonLeftDown && !input.MouseButtonLeftPressed {
+		s.dragging = false
+	}
+	if s.dragging {
+		trackX, _, trackWidth, _ := s.trackBounds()
+		ratio := math.Max(0, math.Min((float64(input.MouseX)-trackX)/trackWidth, 1))
+		s.SetValue(s.Min + ratio*(s.Max-s.Min))
+	}
+}
+
+var _ Adjustable = (*Slider)(nil)
@@ -0,0 +1,247 @@
+package ui
+
+import (
+	"image"
+	"image/color"
+	"slices"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/inpututil"
+	"github.com/hajimehoshi/ebiten/v2/text"
+	"github.com/hajimehoshi/ebiten/v2/vector"
+	"golang.org/x/image/font"
+)
+
+// EditKey is a key that edits the text of a TextInput
+type EditKey int
+
+const (
+	EditBackspace EditKey = iota + 1
+	EditDelete
+	EditLeft
+	EditRight
+	EditHome
+	EditEnd
+	EditSubmit // Ends the edit and submits the text
+	EditCancel // Ends the edit without submitting
+)
+
+var editKeys = map[ebiten.Key]EditKey{
+	ebiten.KeyBackspace:   EditBackspace,
+	ebiten.KeyDelete:      EditDelete,
+	ebiten.KeyArrowLeft:   EditLeft,
+	ebiten.KeyArrowRight:  EditRight,
+	ebiten.KeyHome:        EditHome,
+	ebiten.KeyEnd:         EditEnd,
+	ebiten.KeyEnter:       EditSubmit,
+	ebiten.KeyNumpadEnter: EditSubmit,
+	ebiten.KeyEscape:      EditCancel,
+}
+
+// Held keys repeat after the delay every interval, both in ticks
+const (
+	keyRepeatDelay    = 24
+	keyRepeatInterval = 3
+)
+
+// AppendEditKeys appends the edit keys that were pressed in this tick. Keys
+// that are held down repeat like they do in every other text field.
+func AppendEditKeys(keys []EditKey) []EditKey {
+	for key, editKey := range editKeys {
+		duration := inpututil.KeyPressDuration(key)
+		repeat := editKey != EditSubmit && editKey != EditCancel &&
+			duration >= keyRepeatDelay && (duration-keyRepeatDelay)%keyRepeatInterval == 0
+		if duration == 1 || repeat {
+			keys = append(keys, editKey)
+		}
+	}
+	// Map order is random, a sorted order keeps edits of the same tick predictable
+	slices.Sort(keys)
+	return keys
+}
+
+// A TextInput is a single line of text that can be edited after it got
+// clicked or activated. Characters come from InputState.Chars so
+// composed characters of an IME are typed like every other character.
+type TextInput struct {
+	BaseElement
+	Text             string
+	Placeholder      string // Shown while the text is empty
+	Font             font.Face
+	MaxLength        int // The maximum amount of characters. 0 allows any length.
+	OnChange         func(text string)
+	OnSubmit         func(text string)
+	TextColor        color.Color
+	PlaceholderColor color.Color
+	BackgroundColor  color.Color
+	BorderColor      color.Color
+	editing          bool
+	cursor           int // The position of the cursor in characters
+	blinkTicks       int
+}
+
+func NewTextInput(x, y, width, height float64, placeholder string, fnt font.Face, onSubmit func(text string)) *TextInput {
+	return &TextInput{
+		BaseElement:      *NewBaseElement(x, y, width, height),
+		Placeholder:      placeholder,
+		Font:             fnt,
+		OnSubmit:         onSubmit,
+		TextColor:        color.RGBA{R: 255, G: 255, B: 255, A: 255},
+		PlaceholderColor: color.RGBA{R: 130, G: 130, B: 130, A: 255},
+		BackgroundColor:  color.RGBA{R: 30, G: 30, B: 30, A: 255},
+		BorderColor:      color.RGBA{R: 110, G: 110, B: 110, A: 255},
+	}
+}
+
+// IsEditing returns true while the text input receives the typed characters
+func (t *TextInput) IsEditing() bool {
+	return t.editing
+}
+
+// Activate starts editing with the cursor at the end of the text
+func (t *TextInput) Activate() {
+	t.editing = true
+	t.cursor = len([]rune(t.Text))
+	t.blinkTicks = 0
+}
+
+// Cursor returns the position of the cursor in characters
+func (t *TextInput) Cursor() int {
+	return t.cursor
+}
+
+// SetText replaces the text and moves the cursor to its end
+func (t *TextInput) SetText(txt string) {
+	runes := []rune(txt)
+	if t.MaxLength > 0 && len(runes) > t.MaxLength {
+		runes = runes[:t.MaxLength]
+	}
+	t.cursor = len(runes)
+	t.setRunes(runes)
+}
+
+func (t *TextInput) setRunes(runes []rune) {
+	txt := string(runes)
+	if txt == t.Text {
+		return
+	}
+	t.Text = txt
+	if t.OnChange != nil {
+		t.OnChange(txt)
+	}
+}
+
+func (t *TextInput) Update(input *InputState) {
+	if !t.Visible || !t.Enabled {
+		t.editing = false
+		return
+	}
+	t.blinkTicks++
+	t.BaseElement.Update(input)
+}
+
+func (t *TextInput) HandleInput(input *InputState) {
+	if !t.Visible || !t.Enabled {
+		return
+	}
+
+	// Clicking the text input starts editing, clicking anywhere else ends it
+	if input.MouseButtonLeftPressed {
+		if t.IsMouseOver(input.MouseX, input.MouseY) {
+			if !t.editing {
+				t.Activate()
+			}
+		} else {
+			t.editing = false
+		}
+	}
+	if !t.editing {
+		return
+	}
+
+	runes := []rune(t.Text)
+	// The text could have been changed without SetText
+	t.cursor = min(t.cursor, len(runes))
+	for _, char := range input.Chars {
+		if t.MaxLength > 0 && len(runes) >= t.MaxLength {
+			break
+		}
+		runes = slices.Insert(runes, t.cursor, char)
+		t.cursor++
+	}
+	for _, key := range input.EditKeys {
+		switch key {
+		case EditBackspace:
+			if t.cursor > 0 {
+				runes = slices.Delete(runes, t.cursor-1, t.cursor)
+				t.cursor--
+			}
+		case EditDelete:
+			if t.cursor < len(runes) {
+				runes = slices.Delete(runes, t.cursor, t.cursor+1)
+			}
+		case EditLeft:
+			t.cursor = max(0, t.cursor-1)
+		case EditRight:
+			t.cursor = min(len(runes), t.cursor+1)
+		case EditHome:
+			t.cursor = 0
+		case EditEnd:
+			t.cursor = len(runes)
+		case EditSubmit:
+			t.editing = false
+			t.setRunes(runes)
+			if t.OnSubmit != nil {
+				t.OnSubmit(t.Text)
+			}
+			return
+		case EditCancel:
+			t.editing = false
+		}
+		// The cursor stays visible while it moves
+		t.blinkTicks = 0
+	}
+	t.setRunes(runes)
+}
+
+func (t *TextInput) Draw(screen *ebiten.Image) {
+	if !t.Visible {
+		return
+	}
+
+	vector.DrawFilledRect(screen, float32(t.X), float32(t.Y), float32(t.Width), float32(t.Height), t.BackgroundColor, false)
+	borderColor := t.BorderColor
+	if t.editing {
+		borderColor = t.TextColor
+	}
+	vector.StrokeRect(screen, float32(t.X), float32(t.Y), float32(t.Width), float32(t.Height), 1, borderColor, false)
+
+	if t.Font != nil {
+		const paddingX = 6
+		// Long texts are cut off at the border instead of drawing over it
+		clipped := screen.SubImage(image.Rect(int(t.X), int(t.Y), int(t.X+t.Width), int(t.Y+t.Height))).(*ebiten.Image)
+		ascent := float64(t.Font.Metrics().Ascent.Ceil())
+		lineHeight := float64(t.Font.Metrics().Height.Ceil())
+		textX := t.X + paddingX
+		textY := t.Y + (t.Height-lineHeight)/2 + ascent
+		if t.Text == "" && !t.editing {
+			text.Draw(clipped, t.Placeholder, t.Font, int(textX), int(textY), t.PlaceholderColor)
+		} else {
+			text.Draw(clipped, t.Text, t.Font, int(textX), int(textY), t.TextColor)
+		}
+
+		// The cursor blinks twice per second
+		if t.editing && (t.blinkTicks/30)%2 == 0 {
+			runes := []rune(t.Text)
+			cursorX := textX + float64(font.MeasureString(t.Font, string(runes[:min(t.cursor, len(runes))])).Ceil())
+			vector.StrokeLine(clipped, float32(cursorX), float32(textY-ascent), float32(cursorX), float32(textY-ascent+lineHeight), 1, t.TextColor, false)
+		}
+	}
+
+	t.BaseElement.Draw(screen)
+}
+
+var (
+	_ Focusable = (*TextInput)(nil)
+	_ Editable  = (*TextInput)(nil)
+)
@@ -0,0 +1,113 @@
+package ui
+
+import (
+	"image/color"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/text"
+	"github.com/hajimehoshi/ebiten/v2/vector"
+	"golang.org/x/image/font"
+)
+
+// A Toggle switches a setting on and off when it gets clicked.
+// The label is drawn on the left and the switch on the right.
+type Toggle struct {
+	BaseElement
+	Label     string
+	Font      font.Face
+	Value     bool
+	OnChange  func(value bool)
+	TextColor color.Color
+	OnColor   color.Color
+	OffColor  color.Color
+	KnobColor color.Color
+	isHovered bool
+}
+
+func NewToggle(x, y, width, height float64, label string, fnt font.Face, value bool, onChange func(value bool)) *Toggle {
+	return &Toggle{
+		BaseElement: *NewBaseElement(x, y, width, height),
+		Label:       label,
+		Font:        fnt,
+		Value:       value,
+		OnChange:    onChange,
+		TextColor:   color.RGBA{R: 255, G: 255, B: 255, A: 255},
+		OnColor:     color.RGBA{R: 110, G: 160, B: 80, A: 255},
+		OffColor:    color.RGBA{R: 50, G: 50, B: 50, A: 255},
+		KnobColor:   color.RGBA{R: 220, G: 220, B: 220, A: 255},
+	}
+}
+
+// SetValue changes the value and calls OnChange if it changed
+func (t *Toggle) SetValue(value bool) {
+	if value == t.Value {
+		return
+	}
+	t.Value = value
+	if t.OnChange != nil {
+		t.OnChange(value)
+	}
+}
+
+func (t *Toggle) Update(input *InputState) {
+	if !t.Visible || !t.Enabled {
+		t.isHovered = false
+		return
+	}
+	t.isHovered = t.IsMouseOver(input.MouseX, input.MouseY)
+	t.BaseElement.Update(input)
+}
+
+func (t *Toggle) Draw(screen *ebiten.Image) {
+	if !t.Visible {
+		return
+	}
+
+	if t.Font != nil {
+		bounds := text.BoundString(t.Font, t.Label)
+		textY := t.Y + (t.Height-float64(bounds.Dy()))/2 - float64(bounds.Min.Y)
+		text.Draw(screen, t.Label, t.Font, int(t.X), int(textY), t.TextColor)
+	}
+
+	// The switch is twice as wide as it is high
+	switchHeight := t.Height * 0.6
+	switchWidth := switchHeight * 2
+	switchX := t.X + t.Width*labelShare
+	switchY := t.Y + (t.Height-switchHeight)/2
+	bgColor := t.OffColor
+	knobX := switchX + switchHeight/2
+	if t.Value {
+		bgColor = t.OnColor
+		knobX = switchX + switchWidth - switchHeight/2
+	}
+	vector.DrawFilledRect(screen, float32(switchX), float32(switchY), float32(switchWidth), float32(switchHeight), bgColor, false)
+
+	knobRadius := switchHeight * 0.4
+	if t.isHovered {
+		knobRadius = switchHeight * 0.5
+	}
+	knobColor := t.KnobColor
+	if !t.Enabled {
+		knobColor = color.RGBA{R: 90, G: 90, B: 90, A: 255}
+	}
+	vector.DrawFilledCircle(screen, float32(knobX), float32(switchY+switchHeight/2), float32(knobRadius), knobColor, true)
+
+	t.BaseElement.Draw(screen)
+}
+
+// Activate switches the toggle
+func (t *Toggle) Activate() {
+	t.SetValue(!t.Value)
+}
+
+func (t *Toggle) HandleInput(input *InputState) {
+	if !t.Visible || !t.Enabled {
+		return
+	}
+
+	if t.IsMouseOver(input.MouseX, input.MouseY) && input.MouseButtonLeftPressed {
+		t.Activate()
+	}
+}
+
+var _ Focusable = (*Toggle)(nil)
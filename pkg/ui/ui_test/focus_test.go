@@ -0,0 +1,127 @@
+package ui_test
+
+import (
+	"testing"
+
+	"github.com/N3moAhead/harvest/pkg/ui"
+)
+
+// A column of three buttons and a slider to the right of the first button
+type focusTestMenu struct {
+	manager        *ui.UIManager
+	buttons        []*ui.Button
+	slider         *ui.Slider
+	clickedButtons []int
+}
+
+func newFocusTestMenu() *focusTestMenu {
+	menu := &focusTestMenu{manager: ui.NewUIManager(800, 600)}
+	column := ui.NewContainer(0, 0, &ui.ContainerOptions{Direction: ui.Col, Gap: 10})
+	for i := range 3 {
+		btn := ui.NewButton(0, 0, 100, 40, "", nil, func() { menu.clickedButtons = append(menu.clickedButtons, i) })
+		menu.buttons = append(menu.buttons, btn)
+		column.AddChild(btn)
+	}
+	menu.manager.AddElement(column)
+	menu.slider = ui.NewSlider(200, 0, 200, 40, "Volume", nil, 0, 1, 0.5, nil)
+	menu.manager.AddElement(menu.slider)
+	return menu
+}
+
+func (m *focusTestMenu) press(state ui.InputState) {
+	m.manager.UpdateWithInput(&state)
+	// Releasing the direction so the next press counts again
+	m.manager.UpdateWithInput(&ui.InputState{})
+}
+
+func TestFirstInputOnlyShowsTheFocus(t *testing.T) {
+	menu := newFocusTestMenu()
+	if menu.manager.Focused() != nil {
+		t.Fatal("Expected no focus before any navigation")
+	}
+	menu.press(ui.InputState{Confirm: true})
+	if menu.manager.Focused() != menu.buttons[0] {
+		t.Fatalf("Expected the first button to be focused, got %v", menu.manager.Focused())
+	}
+	if len(menu.clickedButtons) != 0 {
+		t.Errorf("Expected the first confirm not to click, got %v", menu.clickedButtons)
+	}
+	menu.press(ui.InputState{Confirm: true})
+	if len(menu.clickedButtons) != 1 || menu.clickedButtons[0] != 0 {
+		t.Errorf("Expected the first button to be clicked, got %v", menu.clickedButtons)
+	}
+}
+
+func TestDirectionalNavigation(t *testing.T) {
+	menu := newFocusTestMenu()
+	menu.manager.SetFocus(menu.buttons[0])
+
+	menu.press(ui.InputState{MoveY: 1})
+	if menu.manager.Focused() != menu.buttons[1] {
+		t.Fatal("Expected down to focus the second button")
+	}
+	menu.press(ui.InputState{MoveY: 1})
+	menu.press(ui.InputState{MoveY: 1})
+	if menu.manager.Focused() != menu.buttons[2] {
+		t.Fatal("Expected the focus to stop at the last button")
+	}
+	menu.press(ui.InputState{MoveY: -1})
+	menu.press(ui.InputState{MoveY: -1})
+	menu.press(ui.InputState{MoveX: 1})
+	if menu.manager.Focused() != menu.slider {
+		t.Fatal("Expected right to focus the slider next to the first button")
+	}
+}
+
+func TestHoldingADirectionMovesTheFocusOnce(t *testing.T) {
+	menu := newFocusTestMenu()
+	menu.manager.SetFocus(menu.buttons[0])
+	for range 5 {
+		menu.manager.UpdateWithInput(&ui.InputState{MoveY: 1})
+	}
+	if menu.manager.Focused() != menu.buttons[1] {
+		t.Error("Expected a held direction to move the focus only once")
+	}
+}
+
+func TestTabOrderWraps(t *testing.T) {
+	menu := newFocusTestMenu()
+	menu.manager.SetFocus(menu.slider)
+	menu.press(ui.InputState{FocusNext: true})
+	if menu.manager.Focused() != menu.buttons[0] {
+		t.Error("Expected the tab order to wrap to the first button")
+	}
+	menu.press(ui.InputState{FocusPrevious: true})
+	if menu.manager.Focused() != menu.slider {
+		t.Error("Expected shift tab to wrap to the slider")
+	}
+}
+
+func TestHorizontalInputAdjustsTheFocusedSlider(t *testing.T) {
+	menu := newFocusTestMenu()
+	menu.manager.SetFocus(menu.slider)
+	menu.press(ui.InputState{MoveX: -1})
+	if menu.manager.Focused() != menu.slider {
+		t.Fatal("Expected the slider to keep the focus")
+	}
+	if menu.slider.Value >= 0.5 {
+		t.Errorf("Expected left to lower the value, got %v", menu.slider.Value)
+	}
+}
+
+func TestHiddenAndClickedElementsLoseTheFocus(t *testing.T) {
+	menu := newFocusTestMenu()
+	menu.manager.SetFocus(menu.buttons[1])
+	menu.buttons[1].SetVisible(false)
+	menu.manager.UpdateWithInput(&ui.InputState{})
+	if menu.manager.Focused() != nil {
+		t.Error("Expected a hidden element to lose the focus")
+	}
+
+	menu.buttons[1].SetVisible(true)
+	menu.manager.SetFocus(menu.buttons[1])
+	menu.manager.UpdateWithInput(&ui.InputState{MouseX: 500, MouseY: 500, MouseButtonLeftPressed: true})
+	if menu.manager.Focused() != nil {
+		t.Error("Expected a mouse click to remove the focus")
+	}
+}
@@ -0,0 +1,138 @@
+package ui_test
+
+import (
+	"testing"
+
+	"github.com/N3moAhead/harvest/pkg/ui"
+)
+
+func assertPosition(t *testing.T, name string, element ui.UIElement, wantX, wantY float64) {
+	t.Helper()
+	if x, y := element.GetPosition(); x != wantX || y != wantY {
+		t.Errorf("Expected %s at (%v, %v), got (%v, %v)", name, wantX, wantY, x, y)
+	}
+}
+
+func assertSize(t *testing.T, name string, element ui.UIElement, wantWidth, wantHeight float64) {
+	t.Helper()
+	if width, height := element.GetSize(); width != wantWidth || height != wantHeight {
+		t.Errorf("Expected %s to be %vx%v, got %vx%v", name, wantWidth, wantHeight, width, height)
+	}
+}
+
+func TestAnchorPlace(t *testing.T) {
+	testCases := []struct {
+		anchor       ui.Anchor
+		wantX, wantY float64
+	}{
+		{ui.AnchorTopLeft, 5, 5},
+		{ui.AnchorTop, 45, 5},
+		{ui.AnchorCenter, 45, 45},
+		{ui.AnchorRight, 85, 45},
+		{ui.AnchorBottomRight, 85, 85},
+		{ui.AnchorBottomLeft, 5, 85},
+	}
+	for _, tc := range testCases {
+		x, y := tc.anchor.Place(100, 100, 20, 20, 5, 5)
+		if x != tc.wantX || y != tc.wantY {
+			t.Errorf("Anchor %d: expected (%v, %v), got (%v, %v)", tc.anchor, tc.wantX, tc.wantY, x, y)
+		}
+	}
+}
+
+func TestContainerFitsItsChildren(t *testing.T) {
+	container := ui.NewContainer(10, 20, &ui.ContainerOptions{Direction: ui.Col, Gap: 5, Padding: 2})
+	first := ui.NewBaseElement(0, 0, 30, 10)
+	second := ui.NewBaseElement(0, 0, 50, 20)
+	container.AddChild(first)
+	container.AddChild(second)
+
+	assertSize(t, "the container", container, 54, 39)
+	assertPosition(t, "the first child", first, 12, 22)
+	assertPosition(t, "the second child", second, 12, 37)
+}
+
+func TestContainerAlignment(t *testing.T) {
+	container := ui.NewContainer(0, 0, &ui.ContainerOptions{Direction: ui.Col, Align: ui.AlignCenter})
+	narrow := ui.NewBaseElement(0, 0, 20, 10)
+	wide := ui.NewBaseElement(0, 0, 60, 10)
+	container.AddChild(narrow)
+	container.AddChild(wide)
+	assertPosition(t, "the narrow child", narrow, 20, 0)
+	assertPosition(t, "the wide child", wide, 0, 10)
+}
+
+func TestContainerJustifyAndStretch(t *testing.T) {
+	container := ui.NewContainer(0, 0, &ui.ContainerOptions{
+		Direction: ui.Row,
+		Justify:   ui.AlignEnd,
+		Align:     ui.AlignStretch,
+		Width:     100,
+		Height:    40,
+	})
+	child := ui.NewBaseElement(0, 0, 30, 10)
+	container.AddChild(child)
+	assertPosition(t, "the child", child, 70, 0)
+	assertSize(t, "the child", child, 30, 40)
+}
+
+func TestFlexChildrenShareTheSpaceLeft(t *testing.T) {
+	container := ui.NewContainer(0, 0, &ui.ContainerOptions{Direction: ui.Row, Width: 200, Height: 20})
+	fixed := ui.NewBaseElement(0, 0, 50, 20)
+	small := ui.NewBaseElement(0, 0, 0, 20)
+	large := ui.NewBaseElement(0, 0, 0, 20)
+	container.AddChild(fixed)
+	container.AddFlexChild(small, 1)
+	container.AddFlexChild(large, 2)
+	assertSize(t, "the small child", small, 50, 20)
+	assertSize(t, "the large child", large, 100, 20)
+	assertPosition(t, "the large child", large, 100, 0)
+
+	// Growing children shrink again when the container gets smaller
+	container.SetSize(110, 20)
+	assertSize(t, "the small child", small, 20, 20)
+	assertSize(t, "the large child", large, 40, 20)
+}
+
+func TestNestedContainersMoveWithTheirParent(t *testing.T) {
+	outer := ui.NewContainer(0, 0, &ui.ContainerOptions{Direction: ui.Col, Gap: 10})
+	inner := ui.NewContainer(0, 0, &ui.ContainerOptions{Direction: ui.Row, Gap: 10})
+	left := ui.NewBaseElement(0, 0, 20, 20)
+	right := ui.NewBaseElement(0, 0, 20, 20)
+	inner.AddChild(left)
+	inner.AddChild(right)
+	outer.AddChild(ui.NewBaseElement(0, 0, 100, 30))
+	outer.AddChild(inner)
+
+	outer.SetPosition(50, 50)
+	assertPosition(t, "the inner container", inner, 50, 90)
+	assertPosition(t, "the right child", right, 80, 90)
+	assertSize(t, "the outer container", outer, 100, 60)
+}
+
+func TestAnchoredElementsFollowTheScreenSize(t *testing.T) {
+	manager := ui.NewUIManager(800, 600)
+	container := ui.NewContainer(0, 0, &ui.ContainerOptions{Direction: ui.Col})
+	container.AddChild(ui.NewBaseElement(0, 0, 100, 50))
+	corner := ui.NewBaseElement(0, 0, 40, 40)
+	manager.AddAnchoredElement(container, ui.AnchorCenter, 0, 0)
+	manager.AddAnchoredElement(corner, ui.AnchorBottomRight, -10, -10)
+
+	assertPosition(t, "the container", container, 350, 275)
+	assertPosition(t, "the corner", corner, 750, 550)
+
+	manager.SetScreenSize(1600, 900)
+	assertPosition(t, "the container", container, 750, 425)
+	assertPosition(t, "the corner", corner, 1550, 850)
+}
+
+func TestFullscreenElementsFollowTheScreenSize(t *testing.T) {
+	manager := ui.NewUIManager(800, 600)
+	overlay := ui.NewBaseElement(20, 20, 100, 100)
+	manager.AddFullscreenElement(overlay)
+	assertPosition(t, "the overlay", overlay, 0, 0)
+	assertSize(t, "the overlay", overlay, 800, 600)
+
+	manager.SetScreenSize(1600, 900)
+	assertSize(t, "the overlay", overlay, 1600, 900)
+}
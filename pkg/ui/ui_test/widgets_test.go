@@ -0,0 +1,293 @@
+package ui_test
+
+import (
+	"testing"
+
+	"github.com/N3moAhead/harvest/pkg/ui"
+)
+
+// The track of a 200px wide slider starts in the middle at x = 100
+func newTestSlider(onChange func(float64)) *ui.Slider {
+	return ui.NewSlider(0, 0, 200, 20, "Volume", nil, 0, 1, 0.5, onChange)
+}
+
+func TestSliderFollowsTheMouseWhileDragging(t *testing.T) {
+	var changes []float64
+	slider := newTestSlider(func(v float64) { changes = append(changes, v) })
+
+	slider.HandleInput(&ui.InputState{MouseX: 150, MouseY: 10, MouseButtonLeftPressed: true, MouseButtonLeftDown: true})
+	if !slider.IsDragging() {
+		t.Fatal("Expected the slider to be dragged after pressing on it")
+	}
+	// Leaving the slider while holding the button keeps dragging
+	slider.HandleInput(&ui.InputState{MouseX: 400, MouseY: 100, MouseButtonLeftDown: true})
+	if slider.Value != 1 {
+		t.Errorf("Expected the value to be clamped to 1, got %v", slider.Value)
+	}
+	slider.HandleInput(&ui.InputState{MouseX: 125, MouseY: 100})
+	if slider.IsDragging() {
+		t.Error("Expected the drag to end after releasing the button")
+	}
+	if slider.Value != 1 {
+		t.Errorf("Expected the value to stay at 1 after releasing, got %v", slider.Value)
+	}
+	if len(changes) != 1 || changes[0] != 1 {
+		t.Errorf("Expected OnChange to be called once with 1, got %v", changes)
+	}
+}
+
+func TestSliderIgnoresPressesOutside(t *testing.T) {
+	slider := newTestSlider(nil)
+	slider.HandleInput(&ui.InputState{MouseX: 150, MouseY: 50, MouseButtonLeftPressed: true, MouseButtonLeftDown: true})
+	if slider.IsDragging() || slider.Value != 0.5 {
+		t.Errorf("Expected a press outside of the slider to do nothing, value %v", slider.Value)
+	}
+}
+
+func TestSliderSnapsToSteps(t *testing.T) {
+	slider := ui.NewSlider(0, 0, 200, 20, "Shake", nil, 0, 2, 1, nil)
+	slider.Step = 0.5
+	slider.SetValue(1.3)
+	if slider.Value != 1.5 {
+		t.Errorf("Expected the value to snap to 1.5, got %v", slider.Value)
+	}
+}
+
+func TestToggleSwitchesOnClick(t *testing.T) {
+	var changes []bool
+	toggle := ui.NewToggle(0, 0, 200, 20, "VSync", nil, false, func(v bool) { changes = append(changes, v) })
+
+	toggle.HandleInput(&ui.InputState{MouseX: 10, MouseY: 10, MouseButtonLeftPressed: true})
+	if !toggle.Value {
+		t.Error("Expected the toggle to be on after a click")
+	}
+	// Holding the button does not toggle again
+	toggle.HandleInput(&ui.InputState{MouseX: 10, MouseY: 10, MouseButtonLeftDown: true})
+	toggle.HandleInput(&ui.InputState{MouseX: 10, MouseY: 10, MouseButtonLeftPressed: true})
+	if toggle.Value {
+		t.Error("Expected the toggle to be off after a second click")
+	}
+	if len(changes) != 2 || !changes[0] || changes[1] {
+		t.Errorf("Expected OnChange with true and false, got %v", changes)
+	}
+}
+
+func TestDisabledToggleIgnoresClicks(t *testing.T) {
+	toggle := ui.NewToggle(0, 0, 200, 20, "VSync", nil, false, nil)
+	toggle.Enabled = false
+	toggle.HandleInput(&ui.InputState{MouseX: 10, MouseY: 10, MouseButtonLeftPressed: true})
+	if toggle.Value {
+		t.Error("Expected a disabled toggle to ignore clicks")
+	}
+}
+
+func TestSliderAdjustUsesSteps(t *testing.T) {
+	slider := ui.NewSlider(0, 0, 200, 20, "Shake", nil, 0, 2, 1, nil)
+	slider.Step = 0.5
+	slider.Adjust(-1)
+	if slider.Value != 0.5 {
+		t.Errorf("Expected one step down to 0.5, got %v", slider.Value)
+	}
+	slider.Adjust(10)
+	if slider.Value != 2 {
+		t.Errorf("Expected the value to be clamped to 2, got %v", slider.Value)
+	}
+}
+
+func newEditingTextInput(t *testing.T) *ui.TextInput {
+	t.Helper()
+	textInput := ui.NewTextInput(0, 0, 200, 30, "Name", nil, nil)
+	textInput.HandleInput(&ui.InputState{MouseX: 10, MouseY: 10, MouseButtonLeftPressed: true})
+	if !textInput.IsEditing() {
+		t.Fatal("Expected a click to start editing")
+	}
+	return textInput
+}
+
+func TestTextInputTypesCharacters(t *testing.T) {
+	textInput := newEditingTextInput(t)
+	var changes []string
+	textInput.OnChange = func(text string) { changes = append(changes, text) }
+
+	// Composed characters of an IME arrive like any other character
+	textInput.HandleInput(&ui.InputState{Chars: []rune("Kä")})
+	textInput.HandleInput(&ui.InputState{Chars: []rune("se")})
+	if textInput.Text != "Käse" || textInput.Cursor() != 4 {
+		t.Errorf("Expected 'Käse' with the cursor at 4, got %q at %d", textInput.Text, textInput.Cursor())
+	}
+	if len(changes) != 2 {
+		t.Errorf("Expected OnChange once per tick, got %v", changes)
+	}
+}
+
+func TestTextInputEditKeys(t *testing.T) {
+	textInput := newEditingTextInput(t)
+	textInput.SetText("carot")
+
+	textInput.HandleInput(&ui.InputState{EditKeys: []ui.EditKey{ui.EditLeft, ui.EditLeft}})
+	textInput.HandleInput(&ui.InputState{Chars: []rune("r")})
+	if textInput.Text != "carrot" {
+		t.Errorf("Expected 'carrot', got %q", textInput.Text)
+	}
+	textInput.HandleInput(&ui.InputState{EditKeys: []ui.EditKey{ui.EditHome, ui.EditDelete}})
+	textInput.HandleInput(&ui.InputState{EditKeys: []ui.EditKey{ui.EditEnd, ui.EditBackspace}})
+	if textInput.Text != "arro" || textInput.Cursor() != 4 {
+		t.Errorf("Expected 'arro' with the cursor at 4, got %q at %d", textInput.Text, textInput.Cursor())
+	}
+}
+
+func TestTextInputMaxLength(t *testing.T) {
+	textInput := newEditingTextInput(t)
+	textInput.MaxLength = 3
+	textInput.HandleInput(&ui.InputState{Chars: []rune("leek")})
+	if textInput.Text != "lee" {
+		t.Errorf("Expected the text to be cut at 3 characters, got %q", textInput.Text)
+	}
+}
+
+func TestTextInputSubmitAndCancel(t *testing.T) {
+	var submitted []string
+	textInput := newEditingTextInput(t)
+	textInput.OnSubmit = func(text string) { submitted = append(submitted, text) }
+
+	textInput.HandleInput(&ui.InputState{Chars: []rune("Bob"), EditKeys: []ui.EditKey{ui.EditSubmit}})
+	if textInput.IsEditing() || len(submitted) != 1 || submitted[0] != "Bob" {
+		t.Errorf("Expected 'Bob' to be submitted once, got %v", submitted)
+	}
+	// Characters are ignored while not editing
+	textInput.HandleInput(&ui.InputState{Chars: []rune("x")})
+	if textInput.Text != "Bob" {
+		t.Errorf("Expected the text to stay 'Bob', got %q", textInput.Text)
+	}
+
+	textInput.Activate()
+	textInput.HandleInput(&ui.InputState{EditKeys: []ui.EditKey{ui.EditCancel}})
+	if textInput.IsEditing() || len(submitted) != 1 {
+		t.Error("Expected cancel to end the edit without submitting")
+	}
+
+	textInput.Activate()
+	textInput.HandleInput(&ui.InputState{MouseX: 500, MouseY: 10, MouseButtonLeftPressed: true})
+	if textInput.IsEditing() {
+		t.Error("Expected a click outside to end the edit")
+	}
+}
+
+func TestEditingTextInputKeepsTheFocus(t *testing.T) {
+	manager := ui.NewUIManager(800, 600)
+	column := ui.NewContainer(0, 0, &ui.ContainerOptions{Direction: ui.Col})
+	textInput := ui.NewTextInput(0, 0, 200, 30, "Name", nil, nil)
+	column.AddChild(textInput)
+	column.AddChild(ui.NewButton(0, 0, 200, 30, "Save", nil, nil))
+	manager.AddElement(column)
+	manager.SetFocus(textInput)
+
+	manager.UpdateWithInput(&ui.InputState{Confirm: true})
+	if !textInput.IsEditing() {
+		t.Fatal("Expected confirm to start editing the focused text input")
+	}
+	// Typing an 's' also moves down, the focus has to stay
+	manager.UpdateWithInput(&ui.InputState{Chars: []rune("s"), MoveY: 1})
+	if manager.Focused() != textInput || textInput.Text != "s" {
+		t.Errorf("Expected the text input to keep the focus and get the character, got %q", textInput.Text)
+	}
+	// The enter that submits is also a confirm but must not edit again
+	manager.UpdateWithInput(&ui.InputState{Confirm: true, EditKeys: []ui.EditKey{ui.EditSubmit}})
+	if textInput.IsEditing() {
+		t.Error("Expected submitting to end the edit")
+	}
+}
+
+// Ten rows of 20px in a list that shows 50px
+func newTestScrollList() (*ui.ScrollList, []*ui.Button) {
+	list := ui.NewScrollList(0, 0, 100, 50, 0)
+	var rows []*ui.Button
+	for range 10 {
+		row := ui.NewButton(0, 0, 100, 20, "", nil, nil)
+		rows = append(rows, row)
+		list.AddChild(row)
+	}
+	return list, rows
+}
+
+func TestScrollListScrollsWithTheWheel(t *testing.T) {
+	list, rows := newTestScrollList()
+	list.ScrollSpeed = 10
+
+	list.HandleInput(&ui.InputState{MouseX: 500, MouseY: 10, WheelY: -1})
+	if list.Offset() != 0 {
+		t.Errorf("Expected the wheel to be ignored outside of the list, got offset %v", list.Offset())
+	}
+	list.HandleInput(&ui.InputState{MouseX: 10, MouseY: 10, WheelY: -2})
+	if list.Offset() != 20 {
+		t.Errorf("Expected an offset of 20, got %v", list.Offset())
+	}
+	assertPosition(t, "the second row", rows[1], 0, 0)
+
+	list.HandleInput(&ui.InputState{MouseX: 10, MouseY: 10, WheelY: -100})
+	if list.Offset() != list.MaxOffset() || list.MaxOffset() != 150 {
+		t.Errorf("Expected the offset to stop at 150, got %v", list.Offset())
+	}
+	list.HandleInput(&ui.InputState{MouseX: 10, MouseY: 10, WheelY: 100})
+	if list.Offset() != 0 {
+		t.Errorf("Expected the offset to stop at 0, got %v", list.Offset())
+	}
+}
+
+func TestScrollListOnlyClicksShownRows(t *testing.T) {
+	list, rows := newTestScrollList()
+	clicked := -1
+	for i, row := range rows {
+		row.OnClick = func() { clicked = i }
+	}
+	list.ScrollTo(30)
+	// The third row starts at y = 10 after scrolling
+	list.HandleInput(&ui.InputState{MouseX: 10, MouseY: 15, MouseButtonLeftPressed: true})
+	if clicked != 2 {
+		t.Errorf("Expected the third row to be clicked, got %d", clicked)
+	}
+	// The first row is above the list now
+	clicked = -1
+	list.HandleInput(&ui.InputState{MouseX: 10, MouseY: -25, MouseButtonLeftPressed: true})
+	if clicked != -1 {
+		t.Errorf("Expected rows outside of the list to ignore clicks, got %d", clicked)
+	}
+}
+
+func TestScrollListFollowsTheFocus(t *testing.T) {
+	list, rows := newTestScrollList()
+	manager := ui.NewUIManager(800, 600)
+	manager.AddElement(list)
+	manager.SetFocus(rows[0])
+
+	for range 5 {
+		manager.UpdateWithInput(&ui.InputState{MoveY: 1})
+		manager.UpdateWithInput(&ui.InputState{})
+	}
+	if manager.Focused() != rows[5] {
+		t.Fatal("Expected the focus to move down five rows")
+	}
+	// The sixth row ends at 120, so 70 pixels have to be scrolled
+	if list.Offset() != 70 {
+		t.Errorf("Expected the focused row to be scrolled into view, got offset %v", list.Offset())
+	}
+}
+
+func TestProgressBarRatio(t *testing.T) {
+	bar := ui.NewProgressBar(0, 0, 100, 10, 25, 50)
+	if bar.Ratio() != 0.5 {
+		t.Errorf("Expected 0.5, got %v", bar.Ratio())
+	}
+	bar.Value = 80
+	if bar.Ratio() != 1 {
+		t.Errorf("Expected the ratio to be capped at 1, got %v", bar.Ratio())
+	}
+	bar.Value = -5
+	if bar.Ratio() != 0 {
+		t.Errorf("Expected the ratio to stay at 0, got %v", bar.Ratio())
+	}
+	bar.Max = 0
+	if bar.Ratio() != 0 {
+		t.Errorf("Expected 0 for an empty bar, got %v", bar.Ratio())
+	}
+}
@@ -2,8 +2,6 @@ package util
 
 import (
 	"math/rand/v2"
-
-	"github.com/N3moAhead/harvest/internal/config"
 )
 
 // clamp limits v to [min, max]
@@ -17,9 +15,9 @@ func Clamp(v, min, max float64) float64 {
 	return v
 }
 
-// function to get a random position within the given position range
-func GetRandomPositionInView(posX, camY float64) (float64, float64) {
-	x := posX + rand.Float64()*config.SCREEN_WIDTH
-	y := camY + rand.Float64()*config.SCREEN_HEIGHT
+// function to get a random position within a view of the given size
+func GetRandomPositionInView(posX, posY, viewWidth, viewHeight float64) (float64, float64) {
+	x := posX + rand.Float64()*viewWidth
+	y := posY + rand.Float64()*viewHeight
 	return x, y
 }